@@ -0,0 +1,55 @@
+package nmux
+
+import (
+	"time"
+
+	"github.com/tweekmonster/nmux/screen"
+)
+
+// activityQuiet is how long the screen of a process without a client must be
+// unchanged before new output is reported as activity.
+const activityQuiet = 10 * time.Second
+
+// activityHandler records activity on the screen of a process that no client
+// is viewing and notifies the manager's subscribers.  Output is only reported
+// once until a client attaches, but every bell is reported.
+func (p *Process) activityHandler(a screen.Activity) {
+	now := time.Now()
+	viewed := p.Screen.Sink() != nil
+
+	p.mu.Lock()
+	quiet := now.Sub(p.lastOutput) >= activityQuiet
+	p.lastOutput = now
+	if viewed || p.pool != nil {
+		p.mu.Unlock()
+		return
+	}
+
+	if a == screen.ActivityOutput && (!quiet || p.activity != screen.ActivityNone) {
+		p.mu.Unlock()
+		return
+	}
+
+	if a > p.activity {
+		p.activity = a
+	}
+	p.mu.Unlock()
+
+	p.manager.emitActivity(p, a)
+}
+
+// Activity returns the most important activity since a client was last
+// attached.
+func (p *Process) Activity() screen.Activity {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.activity
+}
+
+// clearActivity is called when a client attaches.
+func (p *Process) clearActivity() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.activity = screen.ActivityNone
+	p.lastOutput = time.Now()
+}
@@ -115,7 +115,7 @@ func webBytesJs() (*asset, error) {
 	return a, nil
 }
 
-var _webIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x94\xc1\x6e\xdb\x30\x0c\x86\xef\x7d\x0a\x4e\xbb\x26\xb1\x7b\x1b\x52\x29\xef\x42\x4b\x8c\xcd\xc6\x92\x0c\x89\x49\xea\x0d\x7b\xf7\xc1\x52\xb2\x26\xc3\x4e\x85\x4f\xa6\x7e\x1a\x1f\xc4\xff\xa7\xad\xbf\xb9\x68\x65\x9e\x08\x06\xf1\xe3\xe1\x45\xd7\x07\x80\x1e\x08\xdd\x52\x00\x68\x4f\x82\x60\x07\x4c\x99\xc4\xa8\xb3\x1c\xb7\x3f\x14\x34\x8f\xcd\x80\x9e\x8c\xba\x30\x5d\xa7\x98\x44\x81\x8d\x41\x28\x88\x51\x57\x76\x32\x18\x47\x17\xb6\xb4\x2d\x87\x0d\x70\x60\x61\x1c\xb7\xd9\xe2\x48\xe6\x75\xd7\x6e\xe0\x9c\x29\x95\x33\x76\x23\x99\x10\xd5\x0d\x2e\x2c\x23\x1d\x82\x3f\x7f\xe8\xa6\xd6\x55\xcf\x32\x8f\x04\xcb\xbd\x8d\x12\xfa\x90\xc6\xe6\xac\xc0\x93\x63\x34\x2a\xdb\x44\x14\x6e\x08\x80\x2e\xba\x79\x53\xc6\x83\x5f\x37\x09\x60\x42\xe7\x38\xf4\x7b\x68\xdf\xfe\x6a\x1e\x53\xcf\xe1\x49\xea\xd0\x9e\xfa\x14\xcf\xc1\xed\xe1\x7b\xdb\x3e\x74\xca\x2c\x7b\x78\x6d\xdb\xcb\xf5\x53\x1d\x88\xfb\x41\xaa\x3c\xdc\xe5\xdf\x2f\xb7\x62\xd7\x8f\xf3\x34\x3c\xdc\xe2\x18\x83\x6c\x8f\xe8\x79\x9c\xf7\xe0\x63\x88\x79\x42\x4b\x6f\xcf\xfd\xcc\x3f\x69\x21\x4e\xf2\x09\x2c\x26\x34\xc5\x85\x12\x56\x73\x4f\x4b\x2f\xd3\xde\x4d\xb2\x89\x27\x81\x9c\xac\x51\x36\x86\x2c\xbb\xf7\xac\x1e\x4d\x7b\xc7\x0b\xd6\x97\xd4\xbf\xf1\x1e\x74\x53\x3b\xff\x61\x65\x49\x1c\xfa\x95\x60\xdd\x2c\x94\x57\x62\x61\x60\x8f\x42\x2b\xd1\x4e\x34\x77\x11\x93\x5b\xcb\xb5\xb2\x95\x6b\xc1\xa2\x3d\xd1\x5a\x79\x2e\x9f\xd7\x57\x51\xba\xa9\x0b\xa7\x9b\xfa\xe3\xf8\x13\x00\x00\xff\xff\x3d\x85\x02\x7f\x50\x04\x00\x00")
+var _webIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x57\xed\x72\xe2\x20\x14\xfd\xdf\xa7\xa0\xd9\x3f\xbb\x33\xa2\xd6\x4e\x9d\xad\xd5\xbe\xca\x0e\xc2\x4d\x42\x4b\x20\x03\x37\xc6\xec\xce\xbe\xfb\x92\x0f\x2b\xd1\xc4\x7e\x8c\x3f\x76\x46\x0d\xb9\xc0\xe1\x7e\x1c\xe0\xb8\xbe\x15\x86\x63\x95\x03\x49\x31\x53\xcf\x37\xeb\xf6\x41\xc8\x3a\x05\x26\xea\x86\x6f\x66\x80\x8c\xf0\x94\x59\x07\xb8\x89\x0a\x8c\xe9\xcf\x88\xcc\xc2\x4e\xcd\x32\xd8\x44\x3b\x09\x65\x6e\x2c\x46\x84\x1b\x8d\xa0\xfd\xe0\x52\x0a\x4c\x37\x02\x76\x92\x03\x6d\x5e\x26\x44\x6a\x89\x92\x29\xea\x38\x53\xb0\xb9\x9b\xce\x27\xa4\x70\x60\x9b\x77\xb6\xf5\x26\x6d\xa2\x0e\x1c\x25\x2a\x78\xd6\x59\xb1\x5f\xcf\xda\x76\x6b\x77\x58\x29\x20\xb5\xdf\x9b\x08\x61\x8f\x33\xee\x5c\x44\x32\x10\x92\x6d\x22\xc7\x2d\x80\xee\x20\x08\xd9\x1a\x51\x4d\x9a\xf0\xc8\x9f\xce\x44\x48\xce\x84\x90\x3a\x59\x91\xf9\xd3\x9b\x2d\x63\x36\x91\xba\x67\xda\x32\xfe\x9a\x58\x53\x68\xb1\x22\xdf\xe6\xf3\xa0\xa7\x89\x65\x45\xee\xe6\xf3\x5d\x79\xb4\xa6\x20\x93\x14\x5b\x73\x7a\x30\xff\xbd\xe9\x1a\xd3\x44\x55\x79\x1a\x78\x11\xfb\x34\xd1\x98\x65\x52\x55\x2b\x92\x19\x6d\x5c\xce\x38\x3c\xf5\xfb\x9d\xfc\x0d\x35\x62\x8e\xe7\x80\xb9\xc9\x8b\x3c\x03\x5d\x04\xa0\x66\x07\x36\x56\xa6\xa4\x1e\x93\x15\x68\x46\xa2\xb9\xbf\xbf\x3f\xf6\x70\xa3\x8c\xf5\x46\x21\x44\x30\xdc\xec\xa9\x4b\x99\x30\xa5\xcf\x09\x59\xe4\x7b\xb2\xf4\x5f\x9b\x6c\xd9\x77\x5f\xb2\xee\x33\x7d\xf8\x71\xc1\x2d\x2a\x11\xb2\xc1\xb4\x93\x3b\x9e\x06\xd9\x4c\xfd\x40\xda\x44\xbf\x22\xb9\x85\xf7\x20\xa7\x0e\x14\x70\x04\x11\x60\xf7\xa2\x5b\x2e\x97\xe7\xd1\xc5\x71\x7c\x09\xd8\xf3\xc8\xb2\x00\xb0\xe5\x03\x55\x10\xfb\x8a\x2e\x42\x77\x0f\x80\x8f\x8f\x8f\xe7\x80\xe8\x39\x2c\x35\x8c\x79\xb6\x58\x2c\x2e\x02\x9d\x24\x43\x9b\xd2\xb2\xfc\xe9\xac\xba\x2b\x92\x4a\x21\x40\x07\x58\x85\x75\x35\x98\x80\x98\x15\x0a\x47\x1d\xa3\xfe\x39\x5c\x92\x20\xc6\xc1\x59\xef\x66\xbd\xb7\x43\x2e\x66\x9d\x67\xe2\x52\x92\xde\x27\xe7\xd1\xed\xe9\x83\xa7\x58\x8f\x4d\x5f\x27\x6e\xe7\x16\xad\x7f\x26\xc7\xd7\xad\x32\xfc\x95\x08\xb9\x0b\xfc\x1d\xa3\xec\x78\x89\xce\x97\x69\x4b\x36\x96\x84\x5e\xbc\x87\x24\x04\x99\x39\xe2\x95\x52\x89\x93\x53\xe0\x43\x24\xa2\xfb\xd3\x23\xe2\x22\xb3\x0f\xcb\x8c\xec\x6a\x6a\xdb\xc3\x6f\x90\x46\xbd\xb9\x43\x44\x1a\xa0\xcb\xf8\x9e\x3e\xe2\x66\xe0\x1c\x4b\xc0\xbd\xb5\x7a\x1b\x78\x4f\xbb\x73\x7a\x39\x0f\x09\xd2\xed\x6c\x34\x79\xc7\x9f\x0f\xf2\xea\x73\x14\x3d\x65\x08\xed\x17\xe1\xeb\x2c\xed\x22\xa5\x90\xb9\x64\x12\xbc\xf2\xd4\x80\xb5\x81\x45\x15\xec\x97\xb7\x98\xd0\x66\x73\xde\xda\x86\x72\xbf\x5c\x92\x5b\x99\xd5\x77\x38\xd3\x38\xbe\x70\xe9\x17\x1e\x9a\xce\x3f\x36\xdd\x21\xc3\xc2\x0d\x00\xf4\x8e\xc1\xcf\xde\x15\x7e\x86\x91\x5e\x76\x58\x0a\x3b\xaf\x3d\x5c\x4d\x7b\x0d\xe3\x5e\xa4\xd2\xa1\xb1\xd5\x09\x5f\x0e\x37\xf8\x43\x70\x81\x5f\xba\x53\xff\x67\xbe\x34\x52\x69\xd6\x68\xa5\x46\xd2\xcd\x0e\x9a\x6e\x5d\x6b\xa2\x83\x94\xe2\x56\xe6\x48\x9c\xe5\x9b\xc8\xcb\x36\x87\xd3\x17\x2f\xa5\x02\x69\xf5\xc2\x76\xac\x1d\x14\x9d\x8a\xc0\x67\x8f\xde\xf4\x0c\x60\x39\xb4\x3e\x2f\x57\x02\xdb\x56\x08\xee\x4a\x58\x4c\xcb\x8c\x21\x5c\x09\xed\x15\xaa\xad\x61\x56\x5c\x2b\x6b\x8d\x76\xbd\x16\x98\xbf\xb5\xe0\x5a\xf5\xac\x45\xf8\x57\xa1\xd6\xb3\x96\x70\x9e\x81\xcd\xdf\x8b\x7f\x14\x31\x53\xb9\x76\x0c\x00\x00")
 
 func webIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -130,12 +130,12 @@ func webIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/index.html", size: 1104, mode: os.FileMode(436), modTime: time.Unix(1490016588, 0)}
+	info := bindataFileInfo{name: "web/index.html", size: 3190, mode: os.FileMode(436), modTime: time.Unix(1792312996, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webKeyboardJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\x56\x51\x6f\xdb\x36\x10\x7e\xf7\xaf\xb8\xa7\xd0\x41\x12\x25\x59\xd1\x3e\xd4\x71\x80\x2c\xde\x53\x9a\x61\x68\x50\x0c\x43\x53\x6c\xb4\x74\xb2\x04\xd1\xa2\x40\x52\xb1\xb9\xc6\xfb\xed\x03\x29\x51\x32\x29\xa9\xdd\x9e\x86\xe5\xc9\x77\xfc\xbe\xbb\xe3\x77\xba\x63\x48\x2d\x11\xa4\x12\x79\xac\xc8\x62\x36\x4b\xeb\x32\x56\x39\x2f\xa1\xdc\xd6\xfb\x47\x5e\x4b\x7c\x40\x3d\xc7\x53\xf8\x3a\x03\x78\xa1\x02\x2a\x81\x69\xbe\x87\x25\x10\xb2\x68\x5d\xb2\x4e\x5b\x97\x25\x98\x30\xcd\x41\x9c\x51\xf1\x94\xff\x89\xb0\x04\x95\xe5\x32\x72\xf6\xfc\xd4\x51\x0d\xeb\x91\xaa\x2c\x4a\x19\xe7\x62\x8e\xd1\x1e\x2e\x3b\xda\xe7\xab\x2f\x1d\x50\x87\x40\x7d\x0c\xbc\x36\xc0\x19\x40\x9e\xc2\x1c\x5f\xb0\x54\x91\xd2\x15\xc2\xb2\xcd\xbb\x35\x65\x45\xf6\x00\x4e\x4e\x4c\x52\xef\x60\x6f\x9c\x3a\x70\xea\xe6\xca\x00\x02\x55\x2d\x4a\x53\xc7\xc1\xa4\x18\x04\x5c\x42\x9f\x71\xe1\x03\xcc\xed\xf6\x81\xcf\x5c\x44\xdb\x62\xe5\x2e\x57\x71\x06\x73\xb4\x54\x97\x2e\xa6\x12\x81\x58\xec\x96\xbf\x20\x79\x6f\xbd\x70\x24\xf2\x4a\xd0\x8d\xd5\xde\xfc\xad\x05\xd2\x62\x11\x32\xeb\x6a\x84\xf7\x11\x19\x52\xdb\x9e\xe6\xe4\xa8\x28\x6e\x00\x17\xd7\x63\x27\x7a\x78\x22\x33\xbe\xbb\xaf\x85\xe4\xa2\x69\xe4\x74\x1d\x09\xdf\x95\x5d\x25\x61\xbe\xfd\x54\x3a\xdd\x89\xdd\x4b\xb4\xae\x95\xe2\xa5\x27\xd2\x95\x0b\xdc\x7f\x92\x1f\x30\x55\xa1\x34\x3d\xe1\x7a\x48\x78\xcc\x93\x84\xe1\x34\xe5\x87\x21\xe5\x63\xbe\xc9\x06\x49\xda\x7a\x9b\x6f\x05\xc8\x0d\x81\x33\x47\x39\x73\x2d\x38\x03\x72\x6b\x0f\xec\xcf\x73\xf3\x4b\x5b\x27\x59\xcc\x0e\x63\x93\xf7\x6b\x86\xc8\x8e\x67\xaf\x40\x23\x0f\x79\x8a\x05\x67\xcc\x9e\xae\x8c\xc0\x8b\xff\x74\xdc\xa2\x04\x99\xa2\xbf\xc1\x0d\x5c\xb9\xf6\x0c\xeb\xfc\x54\x91\x4e\xa4\x86\x25\xb3\x3c\x55\x0f\xa8\x43\xce\x85\xd1\xa5\x40\x1d\xc0\x63\x25\xd8\x10\x7d\x3f\x81\xa6\x6c\x24\xf4\xdd\x04\x78\x8b\x8a\x0e\xd1\xab\x01\xda\x6b\xae\x41\xfd\x9b\x86\x06\x5b\xb4\x49\x82\x51\x1b\xdf\x6e\x51\x23\x88\x75\x3a\x69\x8e\x34\x8e\x79\x82\x91\xac\xd7\x52\x89\xf9\xd5\x39\xbc\x39\x35\xdb\x8a\x3c\xa0\x26\x7e\xd9\x3e\xf2\x4d\x3b\x9e\x26\x86\x0d\xea\xc0\x0e\x5e\xa0\x8e\x14\xff\x54\x55\x28\xee\xa9\x44\x37\xce\x07\x40\x26\x71\x14\xfb\x81\xef\x02\xec\xac\xc3\x8f\x96\xfa\xae\x29\xf5\xe7\x7a\x5b\xd1\x24\xa8\x96\x14\xa8\x8d\x6a\x3e\xe9\x5d\x1b\xd9\x0d\x7f\xd1\xf7\xc6\x2d\x97\x62\x85\x71\xbe\xa5\xac\xdb\x2d\x7d\xc4\x5f\x78\x5e\xf6\xe3\xe9\x2d\xa6\x8e\x7d\x97\x24\x63\x4c\x56\xcb\xef\x10\x9f\xea\xb5\x12\x34\x56\x23\xec\xc7\xbc\x9c\xa2\x7f\x4f\xa2\xb7\x8d\x44\x77\x42\xf0\xdd\x37\xfb\xf9\xd6\x2a\xf3\x0f\x42\xad\xf2\x4d\xae\xba\x50\xee\xcb\x4a\x29\x93\x38\xb2\x5b\x07\x9d\xf5\xdf\x22\x33\x7a\xed\x85\x5b\x07\x2f\x95\xe0\xbe\x8f\x32\xe5\xd9\x36\xa7\xe7\x31\x63\xd6\xe9\xd6\x3f\xaa\xee\x18\xba\x33\xb7\x09\x2a\x1a\x0f\x36\x73\x8f\xbf\x09\xf1\x37\x4c\xdd\x92\x63\xc4\xb9\x97\xfe\xd6\xb3\xa2\x8e\x7d\x79\x09\x77\x55\x45\x05\x96\x8a\x69\x50\x19\x4a\x34\x11\x25\x24\xbc\x24\x0a\x64\x5d\x55\x5c\x28\xd8\xf2\x24\x4f\x73\x14\x32\xf2\x6e\xe0\x16\xc4\x68\x89\xaf\x61\x89\x3f\x52\xd1\x5d\x68\xd0\x94\xd1\x10\xcf\xcf\x83\x18\x92\x51\x99\x4d\xeb\x82\x32\xa6\x15\x86\xac\x9f\x64\x3c\x4d\x49\x90\xa1\x1a\x50\x56\xc8\xa6\x29\x6b\x1a\x17\xd2\x36\x28\x2c\xef\x69\x9a\xf4\x87\xd7\x82\xbf\x3c\xeb\xc2\xb3\x7e\xf7\xac\xa5\x67\x9d\x79\xd6\x67\xcf\xfa\xea\x59\x07\xcf\xfa\xe2\x59\xef\x3d\x6b\xe1\x59\xcf\xa4\xff\xef\xe9\x9b\x5d\xea\x1e\x91\x06\x76\x72\x62\x97\x55\xc4\xb0\xdc\xa8\x0c\x6e\xe1\x1a\x5e\x5f\x87\x7b\xd3\x4c\xe8\x60\xf1\x9e\xfe\x7f\xde\x41\x83\xf6\xaf\x19\x50\xbc\x07\x92\x84\xef\x67\x81\x7a\x76\x98\xfd\x1d\x00\x00\xff\xff\xaa\x37\x84\xb2\x77\x0c\x00\x00")
+var _webKeyboardJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd5\x56\xdf\x4f\xdb\x30\x10\x7e\xe7\xaf\xf0\x13\x6e\x05\x04\xd8\x04\x0f\x14\x90\x3a\xba\xa7\xc1\x34\x81\xd0\x34\x01\xda\xdc\xe4\xd2\x58\x75\xe3\xc8\x76\x68\x33\x60\x7f\xfb\x6c\xa7\x4e\xea\xfc\x80\xed\x6d\xeb\x43\x9b\xbb\xfb\xbe\xf3\xf9\x2e\x77\x57\x9c\x4b\x40\x52\x09\x1a\x2a\x3c\xda\xda\x8a\xf3\x34\x54\x94\xa7\x28\x5d\xe4\xab\x2b\xae\x8d\x9f\xa0\x18\xc0\x10\x3d\x6d\x21\xf4\x48\x04\xca\x04\xc4\x74\x85\xce\x10\xd6\xf0\x52\x25\xf3\x78\xad\xb2\x04\xe3\xa6\x34\x84\x09\x11\x37\xf4\x27\x68\x93\x4a\xa8\x0c\x9c\x3c\x18\x3a\xaa\x61\x5d\x11\x95\x04\x31\xe3\x5c\x0c\x20\x58\xa1\xfd\x8a\x76\x77\xf0\x50\x01\x0b\x1f\xa8\x91\x05\xda\x2b\xbd\xf2\x38\x96\xa0\x06\xc3\xe1\x26\xf5\xd0\x50\x35\x97\xc6\x68\x00\x8f\x90\xaa\x40\x15\x99\x0e\x64\x1d\xc9\xc2\x04\x1a\x58\x03\xda\xde\x36\x61\x78\x86\x95\x51\x16\x0d\x65\x51\x26\x01\x21\x01\x2a\x17\xa9\x89\xec\xc5\x1c\xd1\x72\x78\x86\xea\x13\x47\x3e\xc0\xdc\x77\xd5\xd0\x99\xab\x15\x36\x58\xb9\xa4\x2a\x4c\x74\xc0\x96\xea\x8e\x0b\x89\xae\x10\xb6\xd8\x05\x7f\x04\x7c\x62\xb5\x68\x23\xed\x13\x41\x66\xb6\x1a\xe6\x33\x15\x40\xe6\xa3\x26\x33\xcf\x3a\x78\xd7\xc0\x80\xd8\x82\x95\x96\x8d\xa0\xb8\x01\xec\x1d\x76\x59\x8a\xb6\x45\x26\x7c\x79\x91\x0b\xa9\x2b\x33\x7c\x3d\x8e\x88\x2f\xd3\x2a\x92\xe6\x79\xab\xbe\xe3\x8a\x2a\xd9\x75\x8a\xa6\xb9\x52\x3c\xf5\x92\x74\xe0\x1c\xd7\x2f\xe9\x25\xc4\xaa\x99\x9a\x9a\x70\xd8\x26\x5c\xd1\x28\x62\xd0\x4f\x79\xd7\xa6\x5c\xd3\x59\xa2\xda\xf9\xb7\xf1\x96\xef\x0a\xc2\xa7\x18\xed\x38\xca\x8e\x2b\xc1\x0e\xc2\xe7\xd6\x60\x1f\x77\xcd\x53\x61\x95\xda\xd9\x4b\x57\x2f\x7e\x4d\x00\xd8\x66\x37\xce\xc1\xa4\x07\xdf\x84\x82\x33\x66\xad\x13\x93\xe0\xd1\x3f\xd6\x80\x41\x04\x4c\x91\x6f\xe8\x14\x1d\xb8\x82\xb5\x23\xbf\xcd\x70\x95\xb6\x92\x25\x13\x1a\x2b\x3d\x7f\x9a\x9c\x3d\x93\x29\x2d\x34\xe0\xa1\x12\xac\x8d\xbe\xe8\x41\x13\xd6\xe1\x7a\xdc\x03\x5e\x80\x22\x6d\xf4\xa4\x85\xf6\xca\x6d\x50\x7f\x53\xe2\xc6\xa4\x2d\x0f\x81\x60\xed\xdf\x4e\x5a\x93\x10\xab\x74\xa9\xd9\xc8\x71\xc8\x23\xad\xcf\xa7\x7a\x98\x0f\x0e\x76\xd1\xfb\xa1\x99\x5f\x58\x63\xb0\x1f\xb6\x8f\x7c\xbf\x6e\x58\xe3\xc3\x3a\x75\x60\x07\xd7\xdf\x81\xe2\xb7\x59\x06\xe2\x42\x37\x80\x6b\xf0\x17\x04\x4c\xb7\x43\x17\xf6\x92\x2f\x1b\xd8\xad\x0a\xdf\x19\xea\x71\x19\xea\xe7\x7c\x91\x91\xa8\x11\x2d\xd6\x3f\x26\x6b\x3e\xe9\x78\xed\xd9\x8d\x83\x79\x5d\x1b\x37\x6e\xe6\x13\x08\xe9\x82\xb0\x6a\xda\xd4\x1e\xbf\x70\x9a\xd6\x0d\xeb\x8d\xaa\x8a\x3d\x8e\xa2\x2e\x26\xcb\xe5\x1b\xc4\x9b\x7c\xaa\x04\xd1\xdb\xb4\xcd\xbe\xa2\x69\x1f\xfd\xad\x14\x1d\x95\x29\x1a\x0b\xc1\x97\xaf\xd6\xf3\xc8\x66\xe6\x0f\x5c\x4d\xe8\x8c\xaa\xca\x95\x7b\xb3\x62\xa2\x79\x1d\xd3\xb6\x55\x59\x7f\x3b\x99\xd6\x5b\x5f\x78\xad\xe0\xa9\xd2\x9d\xed\xe9\x74\xc7\x79\xb2\x3d\xd3\xd3\x98\x36\xab\xf2\x56\xaf\x59\x67\x46\x95\xcd\x4d\x82\x8c\x84\xd0\xbf\xf9\x4e\x9b\xf8\x53\xa6\xce\xf1\x26\x62\xd7\x3b\xfe\xdc\x93\x82\x8a\xbd\xbf\x8f\xc6\x59\x46\x84\x5e\xea\xac\xd0\x33\x0f\xb4\x59\x7b\x94\x28\xe2\x29\x56\x7a\x9a\x67\x19\x17\x0a\x2d\x78\x44\x63\x0a\x42\x06\xde\x0d\xdc\x80\xe8\x0c\xf1\xb9\x19\xe2\x07\x22\xaa\x0b\xb5\x8a\xd2\xe9\xe2\xfe\xbe\xe5\x43\x32\x22\x93\xfe\xbc\x80\x0c\x49\x06\x4d\xd6\x47\x19\xf6\x53\xf4\x00\x07\xd5\xa2\x4c\x80\xf5\x53\xa6\x24\x9c\x4b\x5b\xa0\x66\x78\x37\xfd\xa4\x1f\x5e\x09\x7e\x79\xd2\x9e\x27\x7d\xf7\xa4\x33\x4f\xda\xf1\xa4\x3b\x4f\x7a\xf2\xa4\x17\x4f\x7a\xf0\xa4\x13\x4f\x1a\x79\xd2\x3d\xae\xff\x4f\xbd\x5a\xa5\x6a\x89\x94\x30\xfd\xbf\xd2\x0c\xab\x80\x41\x3a\x53\x09\x3a\x47\x87\xe8\xf9\xb9\x3d\x37\x4d\x87\xb6\x06\xef\xf0\xff\xd9\x83\x06\xed\x5f\xb3\x41\xf1\x16\x24\x6e\xee\x4f\x6d\xd0\xdb\xf1\x37\x7c\xff\xa4\x9e\x9b\x0c\x00\x00")
 
 func webKeyboardJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -150,12 +150,12 @@ func webKeyboardJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/keyboard.js", size: 3191, mode: os.FileMode(420), modTime: time.Unix(1490334861, 0)}
+	info := bindataFileInfo{name: "web/keyboard.js", size: 3227, mode: os.FileMode(420), modTime: time.Unix(1792311024, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webNmuxJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x58\x5f\x53\x24\x37\x0e\x7f\xe7\x53\x88\x17\xba\xa7\x68\x1a\x36\x49\x5d\x6d\x31\x19\xae\x36\x90\xab\xe4\xc2\x66\xb7\x60\xb7\xf2\x40\xf1\xe0\xe9\x56\xff\x29\x3c\x76\x9f\xad\x66\x98\xbb\xe5\xbb\x5f\xc9\xed\xfe\x3b\xcc\xc0\x66\x5f\xb6\xb1\x25\xf9\xa7\x9f\x64\x8d\xe4\xa0\xb6\x08\x96\x4c\x99\x50\x30\x3f\x38\x50\xb8\x86\x30\xab\x55\x42\xa5\x56\xe1\x0c\xfe\x77\x00\xf0\x28\x0c\x58\x9d\x3c\xcc\xdb\xef\xc4\xc0\x02\x58\xf2\x36\x31\x88\x2a\x9c\xcd\x0f\x0e\x00\x5a\x2d\x27\x8b\xf4\xf5\xe6\x3a\xb4\x8d\x81\x46\x4d\xc2\x02\xd6\xa5\x4a\xf5\x3a\x96\x3a\x11\x2c\x3a\x77\x9b\x06\xa9\x36\x0a\x42\x19\x57\x46\x93\x4e\xb4\x84\xc5\x62\x01\x41\x41\x54\xd9\xf3\x00\xfe\x09\xc1\xda\xda\xf3\xd3\xd3\x00\xce\xf9\x93\xbf\x66\x70\x0c\x32\x2e\xb4\x25\x25\x56\xe8\xcc\x00\x1c\x43\xc8\x46\xb4\x21\x38\x5c\xc0\xfb\x33\x38\x3a\x82\xfe\xef\x9f\x7e\xfa\x71\xc6\xb6\xce\x03\xa7\xeb\x96\xcf\x21\x70\xa6\x2c\x23\x79\x1e\x79\x91\xe2\x52\xd7\x2a\x41\xc7\x46\x04\x6b\x51\x52\x04\xe5\x6a\x85\x69\x29\x08\x87\x8e\x51\xb9\x42\x5d\xd3\xc8\x99\x09\x85\xad\xa8\x45\x99\xc1\x02\xa8\x28\xed\x7c\xb0\x2c\x4c\x6e\x61\xc1\xff\xd5\x2b\x54\x34\xda\x93\x82\x90\xf9\x7e\xc1\x22\xb4\x47\x73\x38\x6a\x29\xe7\xdd\x7a\x99\x41\x78\xb8\x05\xb6\xf9\xc7\x86\x62\x51\x55\x72\x13\x32\x9c\xc8\x9d\x3e\xeb\x75\x9f\xfd\xd7\xb3\x8b\x6a\x0b\x23\x11\x52\xfe\xa9\xd7\xb0\xe8\x39\x60\x7a\x47\xbe\x03\x24\x12\x85\xf9\xd2\xac\x85\x7e\xaf\x33\xdd\x83\xb5\x48\xad\x90\x73\xaf\xa1\xb7\x13\x64\xf4\xfe\xbc\x21\xf4\xfd\xc0\x1b\xd8\xcf\xdb\x81\x34\x68\xcb\xff\x62\x38\x8c\x58\xc2\x18\x12\x13\x27\x85\x30\xb7\x6e\x73\xde\xed\xb1\x8b\x1f\x05\x15\x71\x26\xb5\x36\x61\xaa\x13\x17\x93\x78\xa9\xd3\x4d\xac\xb3\xcc\x22\xfd\x55\xa6\x54\xc0\x29\x24\x77\x67\xf7\x03\xcd\xe2\x75\xcd\xdf\xb0\xcc\x0b\x72\xaa\xef\x86\xaa\x95\xd8\x48\x2d\x52\x58\xc0\x9d\x77\x47\xad\xea\xa7\xf8\x53\x75\xe3\xc0\x47\x7e\x71\x0d\x17\x17\xf0\x3e\x82\x35\x1c\xc1\xd9\x53\x96\xb5\xeb\x85\x5f\x2f\x46\xeb\xf7\x8d\x7d\xbe\x8e\xb1\x45\x95\x86\x7c\x65\xbf\x96\x8a\xde\x7f\x30\x46\x6c\x42\x7f\xe8\x6c\xb6\xcd\x99\x25\x6d\x30\xe4\xab\x15\xc1\xa3\x90\x75\x97\x42\x1c\x1a\xb7\xe0\xae\x28\x27\x5d\x1f\x21\x9f\xfa\x7c\xb9\xe5\x2d\x69\x23\x72\x8c\x0d\xae\xf4\x23\xfe\x4e\xb8\x72\xd6\xbc\xcb\xcf\x07\x9d\xb1\x2e\xe7\x63\x89\x2a\xa7\x02\x2e\xe0\xdd\x7e\x93\x16\xa9\xb3\x17\xc1\xbf\x6f\x3f\xfd\x19\x73\xfd\x52\x79\x99\x6d\x1a\x6c\xb3\xf1\x31\x64\x36\x53\x83\x4e\xab\x12\xc6\x62\x38\xb2\x9d\x0f\x6c\x77\x56\x20\x11\x94\x14\x10\x32\x09\xde\xa4\x37\xd3\x5e\x3a\xb7\xca\x71\xcc\x4a\x63\xe9\xa6\x56\x7c\xc7\x4d\x8d\x6d\xc5\x4c\x71\x59\xe7\x9c\x73\x8e\xd6\xc0\xc5\xd6\xad\x05\x33\xf8\xf6\x0d\x32\x21\x2d\x8e\x6b\xe8\x0a\xad\x15\x39\xfe\x26\x54\x2a\xd1\x84\xa3\x7a\xb3\xac\x33\x5f\x7f\x6f\x50\xa4\xbc\x1b\xa7\x82\xc4\x20\x9d\x74\xe5\xef\x2e\x27\x79\x51\xa6\x78\x59\x1b\xab\x8d\x2f\xd4\x0d\xf1\xee\xfc\x9e\xe9\x44\x2b\xab\x25\xc6\xb9\xd1\x75\x75\xa9\xa5\x14\x95\xc5\x34\x0c\x3e\xfb\xcc\x0c\xb9\x6a\x2e\xeb\x8c\x23\x2a\x4a\x55\xaa\x3c\xe4\xc2\x19\xcc\x82\x31\xd9\xeb\xa2\x94\x08\xe1\x54\xf2\x02\xce\xfa\xb3\x74\x05\x0b\x67\xab\xe6\x64\xec\x50\x35\xb8\x3a\x0a\x8f\x8e\x58\xf0\x70\x31\xbe\x0a\xc3\x82\x70\x7a\x0a\x37\xf8\x9f\xba\x34\x68\x81\x0a\x6c\xd8\x07\x5d\xa1\x11\x4d\x16\x23\x2a\x20\x0d\x4b\x04\xe1\xcb\x00\xff\x89\xca\xd6\x06\x61\x8d\x90\x23\x81\x18\x9a\xcb\x6a\x29\x99\x34\xa7\xd8\x59\xe4\xc2\x15\x77\x62\x4d\xec\xfb\xb2\xe3\x3f\xec\xba\xa4\xa4\x08\x75\x35\x44\x98\x08\x8b\x63\xfc\xe7\xc3\x4a\xdc\x67\x8b\x4f\x81\x7e\x8f\x23\x67\x91\x5c\x75\x62\xaa\xb0\x54\xf4\xe3\x0f\xe1\x2c\x82\xe1\x5f\xb3\xa1\xca\xd2\xa0\x78\xe8\xb8\x1c\x1f\xfe\x59\x48\x24\x1a\x9d\xee\x0a\xe1\x4a\x70\x2c\xee\xee\xe7\x2f\x6c\x5c\xa3\xf2\x71\x6a\x8f\x1b\x4a\xf9\x40\x7b\xc1\x93\x93\xf1\x8f\x0c\x38\x0b\x71\x55\xdb\x22\xdc\x09\xf8\xf9\x60\x72\x6a\x99\x46\x20\x22\xc8\xf2\x08\x96\x79\x04\xb6\x8a\x40\xbe\x05\x04\x0b\x8d\x32\xcc\xa7\x53\xba\x47\x15\x40\x4c\xb3\x70\xb8\x99\xf1\x7d\x65\x1f\xee\x86\x06\xee\xc7\x42\xcb\xb7\x08\xd9\xea\x2d\x42\x4d\xb8\x7d\x98\xc2\x2d\x22\x26\xe8\x24\x33\x3e\xa6\x72\x80\x6a\x4f\x1e\xdc\xd2\x46\x8e\xb2\xc0\x1f\xfc\x81\xc8\x94\xcb\x9a\xd0\xee\x0e\xd7\xde\xfc\xaa\x69\x6a\xd5\xa0\x4a\xd1\x7c\xc1\x27\x7a\x21\x81\x9b\x8a\xfd\x7d\x07\xdc\x60\x35\xcd\xdf\x52\xa5\xf8\xb4\x27\xc8\xae\x81\xda\x9b\x41\x6d\x3b\x70\xeb\x00\xc5\x99\xd1\xab\xcb\x42\x98\x4b\x9d\xe2\x94\x89\x17\xfd\xbb\xc1\x0a\x05\x61\xea\xfc\x4c\xa2\x06\x91\x4b\xdb\x37\xbb\x76\x9b\x18\x2d\xe5\xd4\x35\x5a\x55\xbf\xe4\xaf\x00\x4f\x51\x52\x9b\xc5\xa5\xa2\x77\xff\xd8\x16\xa1\xae\xd8\xee\x32\xb2\xd4\x44\x7a\xf5\x2a\x89\x19\xbd\x22\x62\x5c\x6b\x33\x95\x99\xa6\x9a\xf3\x35\x74\xce\x45\x0d\xfe\xc8\x19\x8f\x18\x69\xd4\x18\x89\x3c\xa6\x37\x33\x78\xc9\x6d\xe7\x56\x6e\x4c\xef\xd0\xd0\xda\xde\xd2\xb0\xa7\x30\x64\xfb\x42\xb2\xdc\xb7\x69\xf7\xc5\xc1\x35\xa3\xec\xc4\x2b\x37\x7f\x1f\x09\xff\x92\xb5\x2d\xa6\x97\x30\xe3\xc5\x49\x1c\x98\x9c\x95\x4e\xf1\xb5\x5b\xf1\xda\xc5\x4a\x36\xaf\x08\xec\x25\xb9\xbd\x78\xc3\x6a\x30\xdd\x5f\xbf\x9a\x4e\x48\xbe\xb5\x61\x87\x22\x48\x9e\x22\x48\x36\x91\x8b\x3d\x0f\x6d\x5b\x24\xdb\x42\xaf\xfb\x66\xe8\x6d\xc4\x5e\xeb\x7c\x48\x6b\xdb\x29\x95\x2a\xd3\x61\x70\x77\x8b\xe6\x11\x0d\x5c\xeb\xfc\x3e\x18\xd5\x36\x2e\x01\x7b\xcf\x48\x31\x13\xb5\xa4\x97\x6c\x4b\x9d\x87\xc1\x57\xf5\xa0\xf4\x5a\xc1\xa7\x2a\x88\x40\x57\x83\x49\x67\xd8\x2f\x4d\xfa\x38\x70\x10\xd2\x7a\x55\x5d\x0b\x4b\xdc\x20\x86\xec\xc6\x5d\xa0\xb9\x81\xd3\xd5\xfd\x74\x60\x7a\x5b\x47\xf8\xab\x4a\xc3\xae\xd1\x9b\x4e\x0b\x0f\xb8\xd9\x6a\x54\x31\xae\x0c\x3e\xa2\xa2\xab\xc6\xcb\x56\x1b\x63\x4b\xba\xfa\x6c\x74\x25\x72\xd1\xcc\xb3\x9e\x12\x0e\xf8\x03\x72\x4e\x05\xc1\xa0\x4f\xc5\x98\x81\x90\xa9\x13\xd2\xc6\x4d\x1d\x1f\x75\x6d\xf1\x57\xb6\xdd\x83\x6d\x24\x69\x53\xf1\x60\x02\xc1\x8a\x45\x78\xf2\x08\xb8\x8d\xc4\x78\x59\x13\x69\x65\x9d\xfa\xa8\x4d\x98\x36\x73\xcd\xff\x0d\x0c\xe6\xcd\x9d\xf5\x07\x6e\x62\x9e\x47\x43\x9e\xdb\x23\xe8\xa6\x18\x40\x69\x71\x07\xc8\xbf\x0a\x44\x39\x01\x39\x31\xeb\x44\xf6\x18\xde\x56\xdb\x05\xa4\x61\xeb\xf4\x14\x3e\x22\x89\x93\xdb\xa2\xcc\xe8\xe4\x0a\x50\x89\xa5\x44\xdb\x4c\x1f\x71\x47\xa8\x33\xc7\x24\xfd\x7c\x75\x72\x75\x11\xf4\xf0\xda\x29\xe5\xd0\x7d\xb4\x9c\x6c\xcf\x2c\x51\x23\xd9\x25\x92\xbf\x88\x57\xbc\x18\x8e\xb7\x86\xf4\x0e\x32\xed\xf0\x01\x37\xd3\x21\x6f\x24\xe4\x7e\xdc\x84\xfb\x6d\xe3\x39\xc7\xcd\xab\xbf\xd4\x59\x86\x86\xe1\xb7\xa3\xe2\x31\xbc\x1b\x0e\x3d\xcd\x3b\xc8\x78\xc6\x1d\x0c\x46\xba\xa6\xbb\xb3\x7b\xe8\xe6\x89\x3f\x70\xb3\xd4\xc2\xa4\xcd\x6e\xa6\x0d\x4f\xb6\x06\x4a\x58\xc0\x59\xe4\x5e\xab\xfa\xa3\xe6\x50\xc2\xcf\x20\xe7\x50\x1e\x1f\x0f\xe6\x98\x9a\xee\x4a\x46\x71\xef\x85\x13\xdf\x39\x7c\xa0\xb0\xdc\x1e\x75\x0f\xfb\x49\xdc\xc1\xea\x0d\xb9\x8d\x44\x2b\x85\x09\x6d\x5d\xb3\xf6\xf9\xed\x77\x55\xd2\x70\x52\xe0\x35\xef\xb0\xf3\xa8\x79\x76\x0b\xfb\xd7\xb7\xe0\x94\xd7\x83\xa6\x08\xb9\x23\x96\xa5\x12\x66\xf3\xc5\xdd\x12\x08\x04\x33\xb4\x74\xac\x06\x9d\x88\x48\x53\x97\xb5\xd7\xa5\x25\x54\x68\xc2\x40\x57\xa8\x82\x68\xfb\xfd\xa9\x7d\x57\x99\x1f\xec\x4c\x82\xb1\xeb\xec\x40\xef\xb3\x7f\x0a\xdc\x3e\xaf\xb1\xdb\x64\x59\xf3\x02\xd7\xac\x44\xf0\xc3\xd9\x59\x5f\x51\x77\xea\x3f\xe0\x26\xd5\x6b\x86\xdc\xd7\xa5\x51\xae\x6e\xab\xb8\x72\xf1\xb7\x94\xea\xea\xbb\x55\x5c\x59\xfa\x0e\xa5\x35\x97\x89\xef\x51\x48\xb4\x22\x7c\xa2\x15\xaa\x7a\x18\xb7\xd1\xe8\xbc\xab\x3c\xef\x2c\xd1\xbe\x40\xf6\x3f\xc1\x3b\xb2\xc5\x3f\x5c\x04\xd1\xe4\x09\xa3\xc7\xdc\xa7\x72\xfb\x44\xe2\x93\xbd\xb1\xed\xe3\xea\xb3\xbb\x79\x77\xe6\xad\xff\x07\x00\x00\xff\xff\xa9\x18\x68\x18\xa9\x16\x00\x00")
+var _webNmuxJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x1a\x5d\x73\xd3\xba\xf2\xbd\xbf\x42\xbc\x60\x67\xea\xba\x2d\x9c\xb9\xc3\xa4\xb4\x4c\x29\xdc\x81\x7b\xf8\x9a\x16\x86\x87\xd2\x07\xc5\x96\x13\x53\xc7\xf2\xb1\xe4\xa6\xb9\xd0\xff\x7e\x77\x25\xf9\x43\xb6\x13\xbb\xe1\x9e\x19\x4e\x5c\x69\xb5\xdf\xbb\x5a\x49\xeb\x14\x82\x11\x21\xf3\x38\x90\xce\xc9\xde\x5e\xca\x56\xc4\x8d\x8a\x34\x90\x31\x4f\xdd\x09\xf9\xb5\x47\xc8\x1d\xcd\x89\xe0\xc1\xed\x49\xf9\x1d\xe4\xe4\x94\x20\xe4\x55\x90\x33\x06\x60\xb0\x90\x90\xc3\x43\xf2\xed\x3d\x61\x09\x5b\xb2\x54\x0a\x22\x17\x54\x12\x9a\x33\x12\xe6\x74\x95\x92\xd9\x1a\x46\x62\x41\x82\x24\x86\x69\x12\xa7\x42\x32\x1a\x12\x1e\x91\xf4\x2e\x5e\xfa\x06\x35\xbb\x97\x2c\x15\x40\x5a\x00\x85\x6b\x27\xe3\x59\x91\x01\xba\xc2\xf1\x88\x23\xe9\x2c\x89\x53\x86\x9f\xc1\x32\x2c\x3f\x57\x71\x12\x96\x10\x4b\x26\x04\x9d\x33\xe1\xdc\x28\x86\x4a\x31\x14\xf3\x4c\x7e\xbb\xfc\xe0\x0a\x2d\x91\x26\x96\x00\x8d\x55\x9c\x86\x7c\xe5\x27\x3c\xa0\x08\x7a\xa2\x26\x73\x26\x8b\x3c\x25\x6e\xe2\x67\x39\x97\x3c\xe0\x00\x79\x7a\x4a\x9c\x85\x94\x99\x98\x3a\xe4\x15\x90\x15\x62\x7a\x78\xe8\x90\x29\x7e\xe2\xd7\x84\xec\x93\xc4\x5f\x70\x21\x53\xba\x64\x0a\x0d\x81\x21\x17\x91\xf0\x5c\x92\x27\xa7\xe4\xc5\x11\x79\xfa\x94\xd4\x7f\xff\xf5\xd7\xf3\x09\xe2\x02\x8c\xfb\xe5\x30\xe0\x53\xa8\x44\x8d\x21\xf1\x05\xa3\x79\xb0\x00\xd0\xea\x73\x9f\x38\x4f\x15\xf1\x57\x0a\xda\x01\xbd\x9d\x22\x96\x5a\x7f\xfe\x4f\x1e\xa7\xae\xe3\x39\x13\x94\xe9\xc1\xd2\x47\xc8\x66\x1c\xbe\x99\x32\xb4\x47\x56\x34\x96\x1e\x89\x97\x4b\x16\xc6\x54\xb2\xa6\x8a\x64\xbc\x64\xbc\x90\x96\x5a\x5a\xde\x51\x82\x0a\x96\x44\xa0\x50\x34\xf2\x49\x63\x98\xe6\x73\xb4\x25\xfc\x14\xca\x2f\x9a\x73\x09\x50\x43\x57\xea\xc1\x48\x4a\xd2\xe8\x69\x45\x92\x9c\x54\xe3\x71\x44\xdc\x27\x1d\x66\xf5\x7f\x88\xc8\xa7\x59\x96\xac\x5d\x64\xc7\x53\xd4\x27\xf5\xda\x07\xf3\xf5\xa0\xfc\xa3\x64\x23\xa0\x49\xf2\x89\xaf\x80\x52\x85\x16\x0d\x65\xc9\x4e\xc0\x71\x41\xf5\x5f\xf5\x98\x6b\xe6\x2a\xd4\x35\xb3\x82\xc9\x12\x48\x89\xa7\xd5\x5b\x01\x22\xf7\x86\x5e\x93\xf5\xed\x8c\x6b\xb6\x1f\xba\x86\xcc\x99\x88\xff\xcb\xdc\xa6\xc5\x02\xe4\x21\xc8\xfd\x60\x41\xf3\x2b\x35\x79\x52\xcd\xa1\x88\x1f\xa9\x5c\xf8\x51\xc2\x79\xee\x86\x3c\x50\x36\xf1\x67\x3c\x5c\xfb\x3c\x8a\x80\xf7\xef\x71\x28\x17\xe4\x90\x04\xd7\x47\x37\x8d\x95\x0b\x7b\x65\xef\xd2\x77\x2c\x9e\x2f\x24\x39\x50\xe4\xf5\x90\x3b\x99\x28\x5c\xc7\x4d\x5c\x19\x5d\x27\x1c\x42\x1f\x02\xdc\xc8\x97\x2e\x8b\x7b\xff\x73\x76\xa9\xa4\xf1\xcc\xe0\x8a\x9c\x9d\x91\x17\xa0\x3e\xf2\x94\x1c\xdd\x47\x51\x39\xbe\x30\xe3\x0b\x6b\xfc\x46\xe3\xc7\x48\x87\x18\x49\x43\x17\xd3\xd3\xb7\x38\x95\x2f\xce\xf3\x9c\xae\x5d\x43\x74\xd2\x13\x0d\x42\xf2\x9c\xb9\x18\xb5\x1e\xf0\x97\x14\x95\x4f\xa1\xad\xd4\x80\x8a\x7e\xf4\xc2\xda\x64\x26\x16\x30\x6f\x24\x57\x80\x00\xd2\x8e\x9f\xb3\x25\xbf\x63\xef\x25\x5b\x2a\x6c\x46\xe4\x87\xbd\x0a\x59\x15\x04\x7e\xc2\xd2\x39\xe8\xf9\x8c\x1c\x6f\x47\x09\x3a\xac\xf0\x79\xe4\x3f\x57\x9f\x3f\xf9\x98\xab\xd3\x79\x1c\xad\x35\x6f\x13\x9b\x8c\xcc\xd7\x6d\x84\x6a\x55\x46\x73\xc1\x5c\x0b\xf7\xbc\x81\xbb\xc2\x02\xc1\x20\x21\xbf\xb8\xa8\x04\x83\xd2\xa0\x29\xa3\x50\x8d\xa2\x1d\xa3\x38\x17\xf2\xb2\x48\x31\xe8\xf3\x82\x95\xbb\x03\x24\x97\x62\x8e\x4e\xa8\xd4\xea\x28\xdb\xaa\x31\xc8\x54\xbf\x7f\x93\x88\x26\x82\xd9\xe9\xd9\xa4\xed\x77\x34\x0d\x13\x96\xbb\x56\x02\x9a\x15\x91\xd9\x6b\x2e\x61\xbb\xc0\x59\x3f\xa4\x92\x36\xdc\x89\x67\x26\x98\xd1\xed\x16\x71\xc8\x2e\x8a\x5c\x80\x8f\x4e\x4e\x6a\xc5\x2b\xfa\xb5\xa6\x03\xc8\x8f\x3c\x01\x0d\xe4\xbc\xc8\x2e\x78\x92\xd0\x4c\xb0\xd0\x75\xbe\x18\xcf\x74\x31\x95\x02\x65\xb4\x28\x8d\x53\x50\xb7\xab\xb2\xec\xc4\xb1\x95\xbd\x5a\xc4\x09\x23\x6e\x1b\xf2\x8c\x1c\xd5\xb4\x78\x06\xfc\x23\x44\x81\xce\x58\x71\xa5\xf9\xaa\x54\x08\xe9\x06\x00\x61\x4f\xb0\x42\xa1\x99\x21\x60\x77\xbd\x64\xff\x14\x31\x84\x3c\xe4\x58\xa6\xb5\x0f\x8b\x58\x4e\xb5\x17\xc3\x46\x4c\x24\x27\x33\x46\xa8\xc9\x0b\xf8\x27\xec\x06\x05\xec\xc1\x2b\x46\xc0\xdc\x84\x36\xd1\x45\x60\x50\x54\x9a\x5a\x58\x61\xc4\x4c\xe6\x57\x60\xda\xf6\x75\x1e\x32\x1f\x62\x15\x83\x97\xb8\x3c\x6b\x72\x18\x50\xa8\x24\x2c\xfe\xa7\xcd\xd4\x5c\x7b\x8b\x71\x81\x7a\x0e\x2d\x07\x9e\xae\xd2\x15\xaa\x8a\x81\xaa\x9e\x3f\x73\x27\x1e\x69\xfe\x35\x69\x2e\x99\xe5\x8c\xde\x56\xba\xb4\x89\x7f\xa1\x09\x93\xd2\xa2\xae\x32\xe3\x92\xa2\x2d\xae\x6f\x4e\x7a\x26\x3e\xb0\xd4\xd8\xa9\x24\xd7\x84\x32\x86\x36\x80\x07\x07\xf6\xae\x43\x14\x06\x3f\x2b\xc4\xc2\xdd\xc8\xf0\xc3\x5e\x8b\x6a\x1c\x42\x96\xf7\x48\x34\x07\x29\xe1\x9f\xc8\x3c\x92\x8c\x61\x02\x81\x2c\x0f\x33\xee\x14\x6e\x59\x4a\xc0\x27\x5a\x5e\xd8\x9c\x8c\x30\x5e\x51\x86\xeb\x26\x82\x1b\x1b\x68\x36\x06\x48\x64\x63\x80\xb4\xb9\x8d\x99\xdc\x8e\x22\x5a\xdc\x25\xa8\x71\x5b\x95\xe3\xfc\xe0\x4a\xae\x13\xcb\x0b\x0c\xe1\x73\x09\x29\x74\x56\x48\x26\xdc\xdd\xfc\xab\x90\x6d\xac\x39\x6c\x39\x2c\xff\x0a\xe5\x57\x8f\x03\xeb\x8c\xfd\x38\x02\x97\x2c\x6b\xfb\x2f\x54\xaa\xec\x7e\x8b\x91\x55\x45\xb5\xd5\x83\xca\xfa\xe0\x4a\x31\xe4\x47\x39\x5f\x5e\x40\x99\x70\xc1\x43\xd6\xd6\x44\xaf\x7c\xc0\x14\x83\x9a\x26\x54\x72\x42\xe1\xa8\x38\x52\x6e\x3b\x5a\x34\x38\x31\x40\xbe\x6d\x8b\x26\x97\xd9\xeb\xf9\x00\xe3\x21\x4b\x64\xe9\xc5\x00\x72\xfc\xaf\x2e\x88\xac\x92\xed\x26\x24\x33\x2e\x25\x5f\x0e\x2a\x31\x92\x03\x20\xb9\x2a\x75\xda\x30\x6d\x57\x53\xb2\xba\x4a\x38\x4f\xf3\xef\x29\xe4\x1e\x72\xea\x69\x24\x9e\xe1\x69\xb4\x06\x2f\xb0\x0e\x9d\x0e\x25\x93\x26\xb6\xad\xa9\x61\x4b\x62\x88\xb6\x99\x64\xb6\x6d\x52\x6c\xb3\x83\xaa\x4e\x51\x88\x81\xc8\xdf\xa6\x84\x7f\x27\x90\x6c\xdb\x41\x18\xe1\x60\xcb\x0e\xa8\x9c\x25\xf8\xf7\x50\x54\x0c\x05\x56\xb0\x1e\x00\xd8\xaa\xe4\x32\xf0\x9a\xd9\xa0\x3d\xbf\x1a\x74\x27\x26\x4d\x69\x83\x02\x79\xc0\x33\xfc\x5b\x7b\xca\xf6\x78\x8a\xeb\x28\x59\x2c\xf8\xaa\x2e\x86\xc6\x29\xf6\x03\x9f\x37\xd5\x5a\x56\x4a\x71\x1a\x71\xd7\xb9\xbe\x62\xf9\x1d\x9c\xd8\x00\xe8\xc6\xb1\x72\x1b\xa6\x80\xf1\x39\x60\x51\x48\x38\x74\xa7\xc3\x84\x4a\xc8\x16\xb5\xf1\xa4\xde\xe6\x39\xcf\xfb\xe8\x30\x9c\xa8\x09\x29\xb8\x9d\xa9\xbc\x89\x69\x62\xeb\x4d\x25\x2c\x35\xfa\x7e\xc8\x2f\x64\x2c\x13\x36\xe0\x1b\xa6\x42\x1e\x80\x0a\x16\x3c\x0e\x98\xe8\xaf\x71\xd4\xdc\xb8\x2a\xa7\x04\xed\xa9\x73\x34\x05\x5d\xea\xfc\xba\x65\xeb\xa9\xc5\x10\x64\x37\x3a\x63\x89\x3d\xf8\x30\xd9\xb4\x77\xa3\x7f\x6a\xd5\xb9\xa5\xae\x3c\xad\x0e\xaf\x94\xd8\x2b\x49\x8e\xb6\xc5\x39\x9c\x29\xee\x62\xb9\x6e\x5b\x23\xcb\x79\x30\x68\x0b\x04\xfa\x04\xc7\xa1\x01\x45\x53\x43\xc3\x4e\x9d\x70\x4a\x24\xcf\xf0\x3e\x07\x54\x90\xa8\xcb\x19\x5e\xc8\xac\xc0\x6b\xb5\x8d\x7e\x5e\x72\x8b\xae\x57\x12\xf7\x2a\xfc\xb6\x75\xf4\x4d\x55\x18\x8b\x0c\x8f\x68\x6f\xef\xe0\x20\xa9\x4e\xb9\x17\x05\x1c\xb5\x96\xfa\x6f\x75\xdc\x3a\x28\xd7\x03\x52\xdb\x7e\x21\x93\x34\x06\xfb\xfc\x8a\xc3\xa9\xd1\x88\x47\xf0\xfc\x37\xed\xa1\x3e\xad\xbe\x9a\x46\x7b\x78\x44\x19\x53\xde\xde\x5d\x81\xa1\x3b\xf6\x28\x96\x97\x7c\x35\x64\x8f\x62\x79\x81\x97\x6f\x43\x40\x57\x2c\x61\x01\x14\x26\x2d\x48\x72\x40\x8e\x3b\x79\x1a\x8e\xbc\xfd\x21\x82\x33\xa3\x02\xc4\x00\x76\xc3\x43\xe1\x36\xc1\x61\x4d\xc0\x52\x9e\x87\xad\x60\x69\x41\xdc\x82\x7d\xb7\x43\xa0\x2a\xb7\x43\xa0\x57\xd9\x10\x16\xc0\x96\x50\x34\xdb\x45\x65\x32\x25\xa4\xf0\x9a\xca\xf5\x8c\xcd\x3c\x63\x96\x1d\xfc\x40\x61\xea\x56\xe4\x38\x5a\x53\x6e\x5b\xf0\xf1\x74\xde\xc5\x61\xa7\xee\xc7\x9b\x81\x9a\xc6\x68\x9c\x5f\xf5\xa5\x73\xe7\x2c\x59\xe4\x30\x33\x94\xd9\xe9\xac\xdf\xd3\x60\x62\x94\xa3\x69\xb8\xae\x9f\x21\x62\xe3\x66\x0b\x75\x69\x32\x25\xf6\xa9\x43\x87\xf4\xb6\x2c\xdc\x2c\x0f\x23\xe2\x9a\xfa\xc2\x48\xeb\x6a\xf1\x3c\x45\xc8\x23\xda\x40\x30\x30\x69\x33\x52\xde\x3f\xee\x72\x3a\xbb\xd0\x77\xf8\x7d\xb9\x21\x61\x77\x6c\x30\xea\xb9\x18\xaa\xc8\xe0\x74\x92\x0e\x15\xf2\xea\x5a\x62\xa8\x34\x83\xdc\xb8\xcc\xe4\xd0\xee\xcb\x53\x59\xd3\xeb\x83\xaa\x6a\x32\x2d\xb9\xab\xc4\x6c\xa7\x68\x90\x6b\x8a\xff\xb3\x43\x5b\xcb\x32\x35\xbf\xf6\x9c\x16\x61\x6a\x7e\xed\x39\xcd\xf9\xd4\xfc\xda\x73\x86\xe1\x69\xf9\xe1\xb5\x4e\xf2\x2c\x80\x4d\x19\xdf\x23\xf6\x36\x65\x90\x11\xe6\xfd\x02\xe2\xf4\xd4\xb1\xd5\xe4\xff\xe3\xbe\xa7\xf4\x24\xcd\x31\x9e\x68\x77\x71\x28\xb1\x88\xab\x63\x5f\x63\x47\x3f\x3e\xd9\xc8\x7e\x83\x60\x69\x4b\xbb\x1a\x52\x28\x1f\x2b\xc6\xa6\xe4\x55\x7a\xcd\x9f\xa8\xe8\x75\xc2\x83\xdb\xde\x88\x83\xc9\xfe\x5c\x85\x33\xa3\x92\x95\x01\xec\x66\x2b\x85\xbb\xbe\x1d\xeb\xad\xae\x1f\x36\x87\x89\xe2\x59\x61\x17\x3b\x89\x7b\x9e\x65\x0c\x76\xd6\x16\x01\xaa\x46\x2d\x12\x3b\x95\xfe\x4d\x0c\x7d\x96\xeb\x08\x72\x7d\x33\x1a\xf7\x77\xf3\xb4\xd9\x67\x30\x7c\xf6\x7c\x44\xd5\x83\xe0\xef\x37\x56\x3e\x38\x3b\xca\xc6\x06\xb0\x6b\xe3\x0a\xff\x0e\x76\x2e\xc5\x74\x2b\x24\x9e\x25\xde\xe3\xf5\xb5\xa5\xcc\xa8\x88\xed\x5c\x65\x94\x18\x36\x59\xbb\xa2\x70\x7d\xe3\x91\x83\xf1\x78\x3f\xea\x13\x4f\x9f\xb1\xb1\x34\x1c\x3a\x21\x8a\xf9\x88\x9d\xc7\xd0\x70\x11\xa1\x87\x6b\xbc\x4e\xba\x7b\x2c\xbf\x9d\xeb\xa8\xea\x7a\xc7\x00\x08\x77\x17\x15\xe0\x15\x47\xcf\xc6\x51\x42\x48\x2a\x0b\xe1\x3a\xc2\x40\xee\x7a\x70\x6f\x10\x0c\x96\xe1\x38\x7a\x00\xf8\x87\xe4\x2e\x8b\x84\xe5\xc3\xc4\x72\x04\xfb\x43\x52\xef\x62\x7c\x86\xeb\x1c\x86\x17\x7a\xb8\x37\x19\x98\xb9\x51\xf9\xa0\x86\xed\xa6\x04\x33\x57\xde\x15\x74\x4f\x37\x75\x01\x32\xf2\xba\xc0\x76\x62\x23\x5a\xc9\xc3\x56\xcd\x84\x2c\xa2\x45\x22\xfb\x2e\x82\xf0\xf6\xc1\xf9\x96\xde\xa6\x7c\x95\x92\xcf\x19\xe8\x9b\x67\x8d\x87\xf7\xe6\x6b\x5d\xeb\x15\x91\x28\xbe\xc3\x62\x99\x7d\xa0\x42\xe2\xf3\xa4\x8b\xca\xbf\x76\x38\x3e\x1f\xf2\xec\xa6\xfd\x7e\x3f\xee\x3d\xf2\x6d\x1a\xba\xd5\x33\xa3\x59\x75\x78\x48\xce\xc5\xad\x7e\xf8\x2b\x04\xc3\x9b\x6e\x92\xc5\xc1\x2d\xe1\x29\xc3\x16\x1a\x1c\x2f\x6f\x7f\xe0\x40\x40\xf0\x05\x5c\x43\xdf\xb2\x35\x99\xd1\xe0\xd6\x27\xe4\x3c\x25\x0c\x8a\xc0\xb5\xc6\x87\x13\x34\x08\x58\x26\x35\xa0\x50\x17\x61\x8e\x28\x75\xe5\x5b\x4f\xe4\xf5\x4d\x4d\xbc\xe5\x8e\xc6\x08\xd4\x68\x81\xd8\xd0\x2b\x22\xd9\x3d\x96\x5a\xfa\xea\x6b\x9f\x38\x3f\xd2\x1f\x29\x2a\xad\xbc\xe7\x52\x43\xd5\xbd\x49\xc4\x73\x7c\x88\x87\x8a\x1e\xd6\x1c\x9d\xc0\xcf\xcb\xea\x26\x4a\x3f\xa4\xc3\xd8\xfe\xbe\xd5\x3c\x82\x04\xf6\x4f\x11\xcd\x35\x22\x36\xe0\xd7\xf1\x8d\x8f\x82\x03\xfe\x1b\xd2\x1a\x57\x57\x57\x9d\x97\x4e\x95\x7e\xd9\xba\xee\x14\xd2\x95\xb4\x8b\x04\xbc\x16\x17\xe4\x55\x85\xef\x48\xd3\xd1\xdd\x3c\xbf\x7f\xc3\x4f\xb3\xef\xc5\xb4\xb5\xd8\x1d\x0a\x00\x5f\xe2\xd9\x27\xcf\x2b\xdf\x01\x58\xc0\x46\x4e\xed\xbb\xc6\xe6\xec\x31\xce\xc6\xa1\x6a\x8b\x68\x8e\x3f\x33\xe3\xba\x4d\xa2\xa3\xcc\x9f\x5a\x99\x3f\x41\x99\x35\x69\xf8\xdb\x56\x24\x22\xfa\x89\x0c\x21\x32\x84\x0b\xcc\x83\xd1\xb9\x74\x7f\xb6\x1c\xbc\xd9\x7e\x01\xeb\x7c\x08\x8f\x88\xe5\xa5\x2f\x7b\xe4\xa8\xaf\xf9\x02\x80\xff\x66\x6b\x81\xe2\x37\xdf\xfd\xf1\x91\xdf\xe8\x48\xa9\xe7\xb5\xc2\x65\x2b\xe9\xb8\xd9\x05\xd0\xa7\xd2\x46\xa7\x40\x5b\x8d\x40\x73\xc6\x69\x1e\xea\x59\xdb\xc3\x3c\xd5\x19\xd6\x54\x0a\x7a\x5c\xd2\x72\x32\xc4\x18\x23\x17\x3d\x9a\x89\xbb\xbd\x1f\x4f\x6a\xdd\x28\xb6\x6a\x44\x6a\x02\x52\x40\x0a\xe5\x49\x27\xf2\x1b\x8a\x32\x87\x70\x57\xbd\xba\xd5\xb1\x66\xf4\xe7\xbc\xbc\x38\xf8\xf1\xe3\x0c\xfe\xff\xe9\x0c\x3d\x5b\x83\x29\x2d\xa1\xbb\xcf\x65\x5f\x23\x18\xb0\xdd\xe9\xba\x60\xe0\xe2\x0c\x2f\x13\xdf\xe8\x44\x50\xb2\xc4\x20\x45\xf3\xec\x4b\xce\x33\x3a\xa7\x3a\xa6\x4d\x86\xad\x63\x04\xdd\xbc\x92\x98\xa1\x50\x90\xd6\x8b\x00\xf2\xb3\x6a\xa1\xf9\xc8\x21\x79\xa9\x8b\xca\x5a\x7a\x0d\x29\xd7\x19\x76\xd9\x10\x67\x89\x20\xd8\x46\xe3\x60\x4f\x04\x03\x1f\x92\x52\x75\x03\xc2\xa4\xf5\xe6\xdd\xee\x4c\x30\x97\x68\x8a\x0d\xb4\xb1\xa2\xf5\x37\x9a\x85\xe2\x1b\x1c\xec\x12\x1e\xa9\x5a\x72\x08\x4b\x60\xab\xec\x67\xf2\xfb\x82\xb1\xa4\xc5\x64\x0b\xad\x02\xd9\x82\xb8\xbb\x6c\x13\x23\x5a\x5b\x90\x8e\x3f\x32\x49\x0f\xae\xf0\xbc\x78\xf0\x86\xb0\x94\xce\x12\x26\x74\x2b\x8d\x5f\x29\x54\xa1\x43\x25\xbd\x7c\x73\xf0\xe6\xcc\xa9\xd9\x2b\x5b\x6e\x9e\xa8\x8f\x52\x27\xdd\x06\x1c\x4f\x43\x56\x61\x6b\xea\x8e\x37\x38\xe8\xda\x53\x4d\xf5\x36\xbd\xb8\x11\xa6\xbd\x40\x56\x3c\x5b\xed\x42\xe8\xe6\xef\xd3\x58\x36\x1b\x40\x70\xcc\x84\xad\x62\x53\x37\x6a\xba\x75\xbf\xa6\x73\x88\xe3\x8e\xae\x7b\x54\xa0\xcc\xe2\x94\xe6\xeb\xaf\xca\x5f\x88\x43\x31\xce\x75\x9e\x71\x2a\x10\x1a\x86\xca\x7e\x1f\xa0\x32\x60\x29\xf8\xb6\xc3\xe1\xb0\x07\xd2\x77\x76\x23\xfb\xfe\xaa\x57\x1d\x76\x00\xa3\x00\xb5\xfc\x66\x4b\xe8\xd2\xd3\x78\xb5\xbe\x75\xa7\xa5\x1e\xf1\xc8\xb3\xa3\xa3\xba\x88\xdb\xb8\x1e\x94\x87\xcf\x5e\x80\xa0\x8e\x50\xcb\x6a\xdd\x25\x2a\x70\x76\x5a\x54\x64\x8f\x5e\xa2\x02\xf4\x11\x8b\x56\x18\x30\x8f\x59\xa0\x4a\xc3\x7b\x69\x1a\x7b\x2b\xbb\x59\x1d\x51\x9b\x12\xd5\xc6\x64\x55\x5f\x61\x35\x33\x6f\x8f\x88\xba\x0a\x71\xbc\x56\x67\x5a\xcd\x73\xed\xca\x65\xe7\x9b\x49\xd9\x1a\xb7\xb1\xab\xf1\x6e\xdd\x3a\x8d\x53\xff\x03\xbb\x75\xd2\x9c\x6c\x2d\x00\x00")
 
 func webNmuxJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -170,12 +170,12 @@ func webNmuxJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/nmux.js", size: 5801, mode: os.FileMode(436), modTime: time.Unix(1490019130, 0)}
+	info := bindataFileInfo{name: "web/nmux.js", size: 11628, mode: os.FileMode(436), modTime: time.Unix(1792313014, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webScreenJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x3a\x7f\x6f\xdc\x36\xb2\xff\xfb\x53\x4c\x70\x48\x24\xc5\xf2\x7a\xd7\xa9\xf3\x02\xfb\xf6\x1e\x52\xa7\x7d\x0e\xd0\xbb\x14\x49\x8a\xf8\xb0\x30\xee\xb8\x12\x25\x11\xe1\x92\x02\xc9\xb5\x76\xdb\xf8\xbb\x3f\x90\x14\x25\x52\xd2\xda\x6e\xfb\xde\xe5\x0f\x67\x35\x1c\x72\x86\xc3\xf9\x4d\x46\x5b\x89\x41\x2a\x41\x32\x15\x5d\x1e\x1d\xdd\x21\x01\x9f\x32\x81\x31\x83\x25\x14\x5b\x96\x29\xc2\x59\x9c\xc0\x6f\x47\x00\x7a\x4c\x62\x5a\xc0\x12\x54\x45\xe4\xe5\xd1\x11\xc0\xe9\x29\x7c\x16\x28\xfb\x8a\x73\x20\x0c\x10\xe3\xaa\xc2\x02\x64\x26\x48\xad\x66\x47\x60\xf0\x67\x1b\xae\x89\x2c\xcd\x22\x00\xd1\x2e\xba\x80\x79\x6a\x7f\xef\xbd\xdf\xf8\x0e\x33\x15\x5d\x40\x14\x69\xc0\xfd\x51\x4b\xb2\xe0\x4c\xc1\x12\xa2\xe8\xb2\x05\x64\x15\x12\x5f\x60\x09\x73\x1f\x70\x3d\x04\xfc\x34\x82\x7c\x28\x0a\x89\xd5\x3f\x7d\x70\x29\x48\xfe\x65\x08\x08\x27\x6e\x85\xe4\xe2\x66\x0c\x0a\xd6\x59\x97\x57\x28\xab\xcc\x1e\xef\x1d\xac\x46\x14\x2b\x15\xc2\xd6\x62\x2b\xab\x00\x22\x70\x8d\x91\x9a\x98\x4d\x76\x98\x7e\x44\x8a\x70\x58\x42\x43\x58\xce\x9b\x59\x8e\xef\x48\x86\x7f\xee\x47\xbe\x7d\x83\x85\x9b\x20\x15\x32\xc4\xe6\xee\x5c\xfe\x87\xf2\x35\xa2\x70\x03\x19\xe7\x22\x27\x4c\x0f\x17\x5c\x40\x2e\x50\x43\x58\x09\x5b\x96\x63\x91\x6d\x05\x95\xd0\x54\x5c\x62\xc0\x2c\x97\xb0\xc1\x58\x81\xc0\x25\x12\x39\xc5\x52\x02\x2f\xec\x6a\x4d\x85\x14\x64\x88\xdd\x49\x50\x15\xde\x47\x02\x9b\x85\x18\x70\x36\x73\xb2\xbb\xe9\xc8\x3b\x51\xc2\x12\x18\x6e\xe0\x17\xc2\xd4\x9b\xb7\x42\xa0\x7d\xbc\x38\x4b\x0c\x86\xd3\x2d\x90\x31\x4b\x5a\xcd\x10\x58\x6d\x05\x03\x06\x2f\xbd\xed\x5f\x3a\x5d\xe8\x66\x08\x2c\xc9\xaf\xf8\x0a\xb1\x3b\x24\xe3\xcc\xfc\x97\x42\x93\x42\xe5\xd6\xb1\xb0\x59\x43\x72\xa5\x85\x2d\xe3\x26\xb9\xf4\x07\x2a\x4c\xca\x4a\x99\x91\x2a\x1c\x91\x6a\x4f\x71\x37\xb1\x81\x63\x88\xea\x5d\x34\x81\xd2\x2d\x51\x79\x38\x21\x9b\x1b\x7e\x37\x64\x72\x97\xc2\x7e\x92\x55\xbb\x28\xc5\x85\x5e\x72\x77\x98\xac\xe2\x35\x2c\x61\x1f\x20\x1c\x16\x47\xcb\xd3\x11\x98\x43\x88\x07\xe6\xdc\x9e\x12\x2c\x21\xe7\xd9\x76\x83\x99\x9a\x65\x02\x23\x85\x7f\xa0\x58\x7f\xc5\x91\xac\x11\x8b\x5a\x01\x95\x33\x89\xd5\x5b\xa5\x04\x59\x6f\x15\x8e\xa3\x8c\x22\x29\xa3\x14\xa2\x92\xee\xeb\xca\xc3\x32\x7c\xe6\x44\xd6\x14\xed\xb5\xd9\x12\x46\x09\xc3\x27\x6b\xca\xb3\xaf\x51\x88\x75\x87\x85\x22\x19\xa2\x6f\x29\x29\xb5\xbf\x89\xd6\x48\x62\x8d\xdd\xe2\x75\x7c\xad\x79\xbe\x9f\xa1\xba\xc6\x2c\xbf\xaa\x08\xcd\xe3\xb2\xa5\x67\xfc\x43\x6f\x20\x25\x56\x57\x7c\x53\x6f\x15\xce\x3f\x69\x0a\x1d\x5e\xeb\x43\x6a\x24\x24\xfe\x91\x72\xa4\xe2\x62\xa6\x61\x9f\xc8\xaf\x38\xb1\xd2\x84\x08\x8e\xc1\x42\x7f\x44\x1b\x42\xf7\x46\x4d\x35\xb3\x0a\xef\xd4\x15\x67\x0a\x5b\x3f\x74\xe3\x0e\xa6\x75\x43\xe5\x8c\x1b\xc7\xf2\x45\x6b\x4d\x3f\x74\xed\x0d\x5d\x1b\x6d\xe9\x16\xb4\xbb\x37\xf4\xf5\x2f\x23\x25\x85\x28\xc9\x06\xf2\xd1\x18\x5f\x9c\xa2\x45\x6b\x4e\xf3\x01\xe9\xbf\x23\x55\xcd\x36\x68\x17\x1b\x40\x1a\xb2\x92\x84\xbc\x04\xb8\xd7\xe9\x80\xb7\x64\xc0\x9c\x3e\x85\xeb\x8e\xf4\xc2\xa3\xfb\xd3\xc1\x8d\x85\x3e\xd6\xd0\x13\x7c\xcb\xf2\xd8\x92\x84\x93\x76\x7e\x02\xa7\x70\x66\x95\x33\x89\x13\xe7\xae\xae\x31\xad\xb1\x30\x1e\xca\xa8\xa1\x76\x51\x56\x9b\xb1\x9c\xf9\x76\x65\x95\xb4\xd5\x77\xa4\x14\xca\xaa\x14\x0c\xd7\x32\x05\x44\xeb\x0a\xf9\xfa\x6d\x97\x78\x40\xc9\x2d\x42\x34\xe5\x07\x6a\x2e\x89\x21\xb9\x84\xa8\x20\x3b\x9c\x1f\x36\xc9\x68\x3e\x6d\xb0\xad\x49\xb7\xc3\xad\x2e\x0a\x88\x8d\x8b\x17\xbc\xd6\x51\xd3\xf2\xee\x98\x0e\x17\x58\x69\xa4\x5b\xed\xaa\x0c\x92\xfd\xb4\x74\xee\x8f\xfa\x4d\xaa\x1d\x2c\xdd\x3c\x63\x04\x4c\x2b\x6d\x1c\x9d\xe5\x51\x0a\xbf\x45\x46\x2a\xd1\x05\x3c\x7b\x66\x7e\xdd\xbb\xd3\x26\x05\xb4\x12\xec\xa9\x1f\x36\x3a\xbb\x7e\x12\x50\xcf\xd4\x6e\x46\x36\xa8\xc4\x9f\x36\x9c\xab\x8a\xb0\xf2\x07\x86\xd6\x14\x6b\xcf\x5f\x20\x2a\x71\x20\x93\x96\x4f\xb5\xbb\xf4\xfd\xbd\x1d\xbc\xf4\x63\xfe\x06\x11\x2d\xf5\xe0\xa8\x95\xd8\x62\xbd\x99\x5f\xdf\xb3\x1c\xeb\x1c\x62\x71\x9f\x84\x21\xf9\xd1\x19\x67\x29\x44\x35\x27\x4c\x61\xf1\x83\x4e\x36\xa4\xce\x36\x18\x67\x38\xba\xef\xf4\xf0\x73\xc3\x75\xfe\x82\x54\x56\x75\xfa\x07\x92\x83\xd2\x01\x10\x29\xa0\x18\x49\x05\xaa\xe1\x9d\x46\x4a\x8d\x07\x02\xeb\x78\xea\xa6\xda\xb5\x8c\x64\xa4\x9e\x85\x40\x91\x0d\x76\x91\xf2\x5f\x2d\xd6\x7b\x3f\x87\x70\x40\x58\xc2\x2a\xd8\x46\x92\x86\xdb\x4a\x6e\xbb\x74\x62\x5b\x14\x78\xb4\xed\x6e\x2b\x9f\xda\x05\x5b\x34\xad\x78\x4a\x20\x26\x6b\x24\xb4\x2f\xb3\x1c\xcb\x8e\x29\xa3\x1b\x9f\xa6\x38\xf3\x47\x46\xec\x99\x63\x4e\xe1\xb7\xfb\x14\xb4\xc0\x35\x77\xed\xbc\x1c\xaf\xb7\xe5\x15\xa7\x5c\x68\x03\x5c\x99\x33\x5f\x89\x72\x1d\xcf\x77\x39\xce\x8a\x57\x45\x92\x82\x28\xd7\xa8\xfb\x4e\x61\x3e\x3b\x4f\x6e\x53\x1f\xf3\xf5\x7c\x9d\xbf\x7e\xd3\x63\xda\xef\x29\xcc\x62\x71\xfe\xe6\xfc\xbb\x1e\xd3\x7e\x4f\x61\x9e\xe7\xe8\x3c\x47\x3d\xa6\xfd\x9e\xc2\x5c\x9f\xfd\xd7\xeb\xf5\x59\x8f\x69\xbf\x3d\xcc\xee\x2c\xcc\x66\x3f\xe2\x4c\x99\xbd\x86\x60\x9d\x06\x6d\x29\xed\x04\x63\x6d\x0e\xe7\x56\x09\x3b\x7c\x93\x2c\xa3\xdc\x82\x7f\x22\x52\x61\x66\x4e\xb7\x0b\xdc\x0c\x6d\x70\x0a\x15\x62\x39\xc5\x22\x85\x0c\xd5\x6a\x2b\xb0\xb3\xdd\xde\x9a\xdd\xca\x33\xa2\x15\xff\x43\x61\x26\x26\xf0\x6c\xb9\x84\x93\x45\x6f\xea\xa7\xa7\xf0\x81\xd1\x3d\x20\x4a\x79\x03\x28\xcf\xb5\xcf\x35\x59\x38\xd0\x96\xba\x04\xce\x32\xa3\xb7\xbd\xc1\x06\x0e\x60\x40\xb0\xde\xca\xca\x52\xb3\x58\xda\x90\x47\x5b\x0a\xf7\x31\x5b\x13\x96\xc7\x7a\xef\x49\xbf\x25\xe3\x0d\x06\x89\xa2\xd5\xbf\xd8\x4f\x9f\x3a\x43\x3a\x3e\xee\x13\x02\x99\x69\xa1\xb9\xa1\x55\x6f\x6c\xcf\x3b\xe0\x8c\x62\x56\xaa\xea\x76\x22\x83\x92\x99\xf0\xd2\xa7\xce\x49\xc9\x4c\x8c\x53\xbc\x76\xb5\xb7\x21\x4f\x81\x1d\x4d\x30\xe6\x8f\xaf\x06\x56\xf7\x3c\x1c\xfe\x73\x7c\x1a\x7d\xd2\x31\x57\xa7\x39\xe3\x7a\xae\x9b\xb3\x6a\xd3\x07\x13\xa6\x6f\x7b\xd1\x9b\xf9\x3a\x8d\x7f\x6c\xbe\x29\xa3\x52\x5b\x3c\x0d\xe7\x4b\xac\x86\xd3\x7d\x69\x69\xa5\x6d\x60\xb9\x6c\x4b\xb1\x17\x2f\xa0\x72\x5f\xd7\xbd\xa6\x4e\x28\x5e\x20\x0c\xad\x66\x29\x34\xf0\x12\xda\xad\x54\xed\xcf\xeb\x64\x42\x74\xd6\x1f\x1e\xc4\xef\xf8\x32\xb6\xeb\x33\xe1\xad\x61\xc6\x1e\x24\xd9\xf2\xe9\x4a\xcc\xe6\xb2\xfb\xd4\x19\x54\x35\x10\x53\x46\x31\x0a\x6c\x9d\xe4\x29\xa0\x14\x8a\x32\x85\x75\x99\x82\xac\x7b\xa1\x8f\x2b\x46\x18\x55\x9b\x30\xa8\x35\xfb\xd3\xf8\xd9\x62\x4e\x10\x08\xf1\xba\x64\x5f\xc6\x24\x6f\xc7\xac\xe4\x74\x1c\x9f\x49\x74\x87\xe3\x31\xb8\x20\x94\xba\xac\xd6\x70\x30\x5b\x97\x93\x48\xda\x55\xc6\xf3\x14\xe6\xa9\x1b\x31\x65\x57\xf7\x55\xb9\xbc\x74\x30\x57\x60\xa9\xb8\xc0\xf1\x03\x07\x65\x3e\xbb\x2a\xce\xfb\x72\x07\x33\xf2\x2d\xda\xd9\x0f\xcb\xd0\xe8\x2f\xba\x22\x88\xa3\xb9\xf9\xa7\x7f\xb3\x99\xe2\x9f\x94\x20\xac\x8c\x17\xaf\x93\x64\x26\xb7\x6b\xa9\x44\x7c\xf2\x3a\xe9\x4c\x4e\x27\x10\x1f\xde\x7d\xb8\x80\x5f\x24\x16\x90\x71\x56\x90\x72\x2b\x74\x46\x04\x39\x2e\xd0\x96\x2a\x28\x4a\x40\x2c\x87\x75\x19\x64\xb5\x45\xf9\xaf\x80\x09\xbd\x2d\x06\x7f\x85\xf9\xd0\x08\x20\xfa\x4b\x61\xfe\x45\xfd\x6e\xba\x31\xbb\xc4\xd8\x51\xad\x7f\xc7\xea\xed\x7e\x7f\xc7\xea\xb2\x0e\x57\x3f\x3d\x85\x9b\x9b\x9b\x0b\x78\xc7\x75\xea\x54\xe3\x8c\x20\x0a\x88\x36\x68\x2f\x3b\x29\x28\x0e\x45\xf9\xdf\xfe\xea\x9d\x04\x26\x2a\x7e\x1d\x7c\x59\x0a\x68\x78\x44\x66\xc0\x1c\x13\x83\xbf\xfd\x0d\x16\xaf\x4d\x31\x97\x1a\x88\x05\xbd\x49\xe0\x05\xcc\x77\x45\xe1\x8d\xb0\x11\x08\xe9\x5f\x49\x14\x7a\xce\xde\x56\x9e\x64\x97\xad\x05\xae\x48\x7e\xdb\xf5\xba\x00\x22\xa4\x94\x88\x2e\x00\xa5\x0e\x50\x94\xd1\x85\xdb\x6c\x51\x26\x1d\x7c\xad\xe1\xed\x41\xad\x3d\xb8\xac\xa3\x0b\x27\x62\x59\xb7\xf0\xfb\xb1\x97\xed\xed\x35\x64\xd7\xaf\x86\xd6\xa6\x08\xee\xf8\x9c\x76\x14\xd6\x6c\x35\xdf\xda\x86\xcd\x0f\x7f\x40\xd6\x06\x2c\xeb\xcb\x4e\x95\x62\x8b\x05\x2f\x80\x6d\xb6\xbb\x99\xe6\xe4\x23\xbe\xc3\x42\xe2\x04\x96\xcb\xe5\x04\xd4\x89\xc7\x2e\x59\x94\x66\x49\xe7\x29\xa0\xf3\x1c\x06\x5c\xb4\xe0\x7b\xc0\x54\xe2\xe9\xa9\xc5\xf4\x54\xb7\xe2\x84\xc9\x4b\xac\x7e\xe4\x4c\xc5\x99\xda\x79\x8a\xe5\x1a\x0a\xba\xf4\xf6\x1c\x4c\x8c\xfc\xbd\x7d\xcf\x69\x3e\xd8\x98\x05\x39\xd6\x0a\x57\xaf\xdb\xa6\x42\x10\x0f\xc6\xcb\xbd\x37\xb5\xff\x60\x41\x07\x0c\x96\xb4\x5d\x82\x89\x45\x8d\x53\xb5\x3d\x8e\x76\x40\x43\x74\x0d\xd8\xf5\x56\x74\x25\x1a\x85\x63\xdf\xb7\xed\x16\x3d\xac\x78\x3d\xd1\xc3\xb2\x25\xc2\x2f\xae\x53\x68\x85\xd5\x75\xb1\xc6\x36\x30\x0c\x0c\xe6\x3b\x43\x14\xc7\x7d\x43\x2f\xf5\x9a\x7b\x1e\x9a\xa9\x4c\x28\x52\x58\x07\x85\x93\x85\x37\xe2\x87\x14\x59\xc3\xb7\x6f\x9d\x22\x0e\x2b\x6a\x62\xaa\x16\x20\xf0\x57\x68\x2e\x81\x1c\x1f\x07\xb9\xee\xe7\x8a\x48\x20\x12\xb6\x72\x8b\x28\xdd\xc3\xbf\x25\x61\xb1\xaa\xb0\x42\x09\xbc\x04\xb4\xa9\x29\x51\xdb\x1c\xff\x5b\x87\x20\x05\x0d\x8e\x04\x06\xae\xf3\x63\xa9\x70\x5d\xeb\xdc\x78\xbd\xef\x57\x5b\xd4\x3b\x97\x16\x07\x21\x6d\x07\xc7\x40\x52\xd3\xa3\x33\x2d\x10\x4d\xa4\xbc\xd1\xc0\x24\x85\x45\x0a\x8b\x71\x01\xed\x85\xb4\x07\x0e\x40\x9f\xd4\x7f\xec\x00\xda\x4e\xcf\x31\x1c\x3a\x88\xa2\xec\x0f\xc2\x19\x5f\x28\x07\xc7\xa4\xbf\xc2\xa3\x3b\xd5\xaa\x2f\x07\xbb\x9c\xf4\xb6\x08\x96\x80\x7a\x16\xac\x93\x3a\x60\x60\x9d\xf8\x06\x36\xe6\xc1\xfb\x28\xf8\x44\x79\x3f\x62\xd3\x9d\xc9\x4c\x91\xb4\xf0\x09\x92\x0f\xdb\x98\xe7\xca\x26\x24\x77\x55\x21\xe1\xcf\xcd\x06\x82\x4b\xa1\xf9\x3f\x55\x12\x4d\xc5\xcf\xc1\x9e\x35\xfd\x96\x1a\x58\xda\x8b\x89\xd0\x41\xf9\xa9\xe1\x94\xca\xd8\x6c\xd0\x36\x37\x5f\xea\xcd\x07\x19\xf9\xa4\x8e\xba\x7e\xe3\x49\xdb\x51\x9c\x52\x54\x97\xd6\x06\xfe\x3e\x44\xfe\x8c\x77\x2a\xce\x0c\xf9\xb9\x4f\x6f\xac\xaf\x26\xda\x5a\x89\x7f\x34\x89\x38\xce\xf5\x64\x3f\xe4\x66\x29\x98\xba\x3b\x05\x8a\x99\x1f\x58\x76\xb0\x84\xd8\x0c\xc1\x73\x5b\x17\x24\xae\x7c\xe8\x8b\xc4\xbd\xeb\x9d\x16\x94\x73\xd1\xa2\x9f\x86\xe8\xd7\x3d\xfa\x57\x58\x42\x06\xc7\x7d\x34\x3c\xee\x23\xe0\xb1\xe7\x28\x1d\x7e\x8d\x34\xaf\x5e\x0d\xb1\xfa\x7a\xdb\x8f\x66\xfa\xe4\x06\x0c\x69\x10\xc5\x4c\x93\x6e\x5c\x97\xf8\x06\x96\xb0\xf3\x0f\xbf\x46\xaa\x3f\xfe\xbe\xd6\x75\x35\x7b\xe6\x1d\xa6\xaf\xf5\x46\x69\x65\x66\x32\x7b\x2b\x7d\xa3\xb8\xbd\x4d\xa7\xdd\xc6\xd2\x6e\x5f\x69\xb7\xad\x6e\x35\xdb\x65\x79\xf1\xa2\xef\xc2\xb4\xfe\xa7\x23\x6c\x0f\xd6\x62\x5b\x19\xb4\x64\xdb\x7e\xf0\xcf\x48\x29\x2c\x98\x2d\xab\x23\x2b\x9f\xc8\xe3\xd6\x97\x97\x49\xa2\x54\xa0\xdf\x07\x19\xe8\xe9\x2f\xfe\x50\x0d\xd5\x11\x9a\x2a\x9f\x64\xbc\x4b\x52\x90\xf1\xde\xfc\x6d\xcc\x5f\x2b\xe6\xc7\xeb\x26\xdf\xdf\xf6\x68\x9d\xe7\x49\x06\x09\xa6\x45\x1f\xaa\x7a\xab\xe7\x52\x89\xff\x6f\x3d\xcf\xac\x1c\x6d\xb3\x2c\x05\xda\x5d\x35\xda\xbb\x4e\xac\x60\xcb\x48\xc6\x73\x6c\xd0\x50\xa6\xb0\x90\x50\x08\xbe\x01\x55\xd9\x0b\x6e\x56\xce\x1c\xba\x2d\xd1\x4c\x3e\x90\x73\x2c\x59\xa4\xa0\x42\x77\x18\xd6\x82\xa3\x1c\xe4\xb6\xae\xb9\x50\x40\x98\x06\x34\x52\xaf\xa4\x2b\xb6\x86\x50\x0a\x0c\xe3\x5c\x17\x2f\x6b\xec\x16\x13\xf8\x8e\x48\xa2\x70\x3e\xeb\x93\x91\x0c\x78\xe1\x4b\xc5\x5e\x8e\xb4\xed\xb1\xac\x53\x2b\xea\xba\x43\x5e\x2f\xdf\x98\x5b\x2f\xae\xa7\x6a\xd7\x59\x32\x65\x9b\xa7\xa7\xf0\xd1\xf6\xa5\x75\xbe\xa7\x77\x64\xa4\x11\xb4\xb7\xf5\x6e\xbe\x62\x5c\xc3\x96\xb9\xcc\x48\x21\x4a\xc1\x5c\xec\x59\x19\xba\xb5\xd6\x14\x63\xd3\x24\x24\x4c\x71\xb0\x77\xfd\x3a\x4c\xca\xd9\xd1\xb4\xdd\x0f\x7d\xb8\xb3\x39\x5f\xf1\x3b\xd8\xe3\x11\xc8\xa1\x1e\x6e\x32\xf8\x18\x5e\x4c\x19\xf1\xd1\x06\x83\xce\xf5\xf4\x1e\x67\x40\xea\x09\x11\xe7\x30\x57\x2e\xf6\x4c\x25\xa8\x74\x90\xa0\x66\xad\xe7\x95\x2b\x72\xeb\xf4\x43\xbb\xd6\x0c\x9e\x2d\x21\x82\xa8\xc7\x0c\x29\xba\xd0\x45\xfa\x4e\x54\xef\xe9\xee\x7d\xed\x72\xb3\x1e\xf2\x04\x03\x5f\xdc\x38\x1c\xcf\x91\xe4\x02\x35\xef\x37\xa8\xc4\xd6\x57\xf6\x3e\xc8\x73\x19\xfd\x9d\xcc\x3b\x6c\xef\x81\x17\xf3\xf9\x3c\x2c\xc0\xd6\x94\xb0\xaf\x57\x06\x29\x56\x29\x64\x7c\xcb\x14\x16\x7e\x87\xa2\x05\xc1\x73\x38\x83\xe5\xd2\x6f\x56\xd8\xb5\xdd\x35\x32\x91\x64\x4d\x28\x51\xe6\xbe\xd9\x7c\x51\x77\x85\x3c\xa8\x19\x1f\x98\x57\x91\x3c\xc7\x2c\x1a\xd6\x8b\xc6\xf9\x55\x24\xc7\x57\xee\x8a\x69\xd4\x05\x35\xc9\x1d\x62\x64\x83\x14\x9e\x09\xbc\xe1\x77\x38\x8e\x2c\xa5\xee\x5a\xf1\x49\x74\xfd\x7a\xbe\xe2\xcd\x61\x8a\x26\xdf\xb4\x0f\x3e\xda\x9c\xf3\xef\x3c\xc7\xdf\x6f\xe5\x5e\xa7\x9b\x43\xc8\xef\xdd\xfc\xb0\xdd\xfa\x64\x81\x5b\x2d\x6b\x88\xf6\x2d\x71\xff\xf2\x67\x66\xae\x14\xfc\xfb\x4d\x89\x21\x32\x43\x5a\x56\xd1\xc5\x18\x9e\xf3\x86\x75\xf0\xc9\xf6\x6f\x20\x74\x94\xe7\x9d\xc4\x53\x5f\xb1\x52\x38\x9f\x6b\xbb\xed\x55\x71\x18\xd7\x24\x56\x63\x39\x6f\x78\x8e\x5d\x24\x24\xb9\x49\x49\x9a\x47\xdb\x28\x26\x0f\x5a\x3f\xd8\xb3\x0e\x53\x2c\x9d\xe3\x5e\x7a\x3e\x3b\x8c\x91\xfe\xf3\x91\x76\x2f\xe5\x8d\xae\x2b\x5f\x3a\x97\x9f\x0d\xbd\x9a\xb9\x5a\x1a\xf4\x6c\x4c\x43\x23\xec\x91\xd8\x07\x4b\x41\x7f\xc4\x78\xee\xbe\xaf\xd3\x2b\xd9\x1f\x6e\xec\x8c\x5b\x3a\x83\x66\xce\x91\xa7\x57\x6d\xc8\xea\x01\xff\x84\x25\xec\x7d\x3e\xf4\x89\xf8\xba\xfe\x0f\x2e\x36\xc8\x2f\xae\x7c\xa0\x9f\x87\x2a\xaf\x04\x70\x5c\x85\x3c\xa9\xcb\xc0\x3e\x42\x87\x75\xd0\x87\x38\xb4\xf3\x0e\xcb\x77\xa6\xb6\x18\xb3\x36\x13\xa6\xb6\xa3\x9a\xec\x72\xe4\x83\x27\xe6\x8d\xaa\xe0\x4b\x5f\x7c\xa3\x90\xea\x83\x1f\x8e\xaa\x87\x25\xfc\x9e\x49\x2c\xd4\x40\xc2\x0e\x38\x70\x29\x87\x6a\xae\x11\x82\x17\x92\x17\x61\x45\xd0\x0a\x79\x92\x95\x8f\xb8\xa6\x28\xc3\x03\x5e\x3a\xe8\x9f\x65\xc6\xbd\x4e\x79\x65\x05\xfd\x2a\xac\xed\xdd\xbb\x3a\xcd\xd4\x48\xba\x61\x7d\xe8\x79\x95\x4c\x70\x4a\x7d\x97\xa2\xcf\x2d\xc7\x54\xa1\x14\x76\x8b\x14\xf6\x8b\x14\x76\x67\x29\xec\xcf\x1c\xff\x83\x40\x13\xfb\x46\x6d\x7c\x46\x1c\xef\xce\xe0\x04\x76\x8b\xc4\xb4\x65\xc6\x19\xb5\xb9\x9b\x8f\xe3\xbd\xc6\xda\x87\x58\xd7\x61\x39\x27\xe3\xac\xf1\x5e\x50\xd9\x47\x72\x99\xbb\x49\x34\x6c\xc2\xcb\x65\x57\x4c\x58\xf0\x6e\xd1\xc3\xbe\xb4\xb0\xfd\x22\xc4\x3b\x90\x06\x5a\x4f\xe5\x6a\x12\x6b\x83\xae\xcf\x3d\xc8\x0c\xa7\x9a\x04\x87\x93\xba\x6a\x90\x82\xf5\xb9\x89\xbb\xe3\x73\xc2\xd6\xc8\x66\xd6\x49\x7b\x0a\xed\xec\xce\x00\xa6\xee\x90\xde\x09\xd4\xc4\x5d\x32\x65\xdc\x6b\xb0\xb1\xb7\xe1\xce\xc0\x0c\x0f\x18\x69\x2f\x0a\x1f\xe3\xc3\xa3\x6a\xab\x52\x8a\x91\xb0\x99\xbe\x37\x73\x02\xb1\xa7\x84\x4c\x3e\x66\xd1\x43\x1d\x3e\x74\x3f\xf7\xc0\xcd\xdc\x04\xd5\xc3\x39\xa0\x47\xf3\x3e\x7c\xfd\xf1\x99\x6c\xcc\x13\x86\x79\x10\x71\xdf\xb5\x8f\x22\x3a\xeb\xc8\xfd\xcc\xc6\x6b\xa4\x4f\x1c\x4c\x18\x58\xbb\x2d\x42\xf7\xd2\xe2\xe1\x27\x40\xaf\x0e\x3f\x01\x6a\xdf\xaf\xf4\x49\xd0\xf8\xba\xd7\x5e\xe6\x76\x89\xb6\xbd\xcc\x1d\xdc\x1c\xb7\x2f\x98\xa4\xe6\x25\xb2\x3b\x6d\xdf\x44\x45\xe3\xd3\xf3\xbd\x76\x00\x7f\xbc\x18\xea\xab\x98\x6e\x9a\xd7\xbd\xea\x8d\x7d\x23\xcb\xf6\x19\x41\xe8\x32\x7c\x72\x7d\x95\x13\x07\x5b\x4c\xe0\x04\x1a\x38\x81\xd8\x25\x2c\xdf\x25\xc3\x46\x4e\xbf\x88\xaf\x5a\xe6\x92\x72\x62\xbf\x43\x13\x86\xe3\x89\xb5\xe1\xa5\x71\xc2\x8f\x10\xe8\x2f\x29\x87\x38\xa6\x24\xda\xc8\xb2\xef\x25\x9e\x1d\xe6\x3a\x70\xe1\x41\x1c\x9a\xf2\x08\xe1\x03\x9f\x7e\x25\xfb\xa4\xea\x1f\x3c\x77\x15\x80\x7d\x32\x67\x97\xb8\x1c\x68\xa8\x7d\x0b\x04\xd3\xf7\x52\x9e\xe3\x71\xed\x5a\xed\x0a\xde\x6b\x8d\xbd\x43\x34\xee\xcd\x6a\xe4\xbd\x0c\x73\xee\xac\x97\x61\xc5\x74\x20\x1b\x15\x26\x29\x31\x65\x69\xda\x3e\x23\xec\x44\xe3\xf5\x41\x87\xdd\xe2\xd2\xbc\x2d\x7f\x4b\xeb\x4a\xe7\x9b\xf3\xd9\x9b\xe1\xd0\x15\xdf\x98\xd7\x93\xf8\x43\x8d\x05\x72\x8f\x28\x73\x2c\x15\x61\xe6\xf3\x84\x30\xef\x36\x6a\xe4\x2e\xe7\x7d\x15\x3b\x55\xb2\x36\x15\xa1\x78\x62\xd7\xde\x7e\xdd\x4e\xec\x70\xcd\xeb\xde\xcc\x32\x37\x66\xdf\xa6\xad\xc4\xea\xbb\xdb\xee\x3c\xc7\x56\xf9\x64\x7b\xd4\x88\x94\x30\xfc\xa5\x7d\x79\xb0\x08\x56\x50\x82\x7f\xc5\x4e\x7d\xb3\xd5\x3c\xa0\xe8\xab\x76\xb6\x5a\x04\x63\xbd\x71\x8a\xd5\xfc\x36\x05\xb1\x5a\xdc\x26\xc3\xc9\xc6\xa8\x16\x26\xad\x12\xab\xb3\x5b\x38\xd1\x3a\x2f\x56\xaf\x6e\xbd\x46\xc5\xa8\x87\x3e\x3b\xb7\x2f\xd9\xc6\x7c\x3e\x7d\xb9\x81\xfd\x00\x90\xbe\xa9\x35\xbc\xa9\x98\x68\x5f\x55\xba\xf2\xa7\x3c\xa8\xfb\x9f\x8d\x1e\xde\x0c\xcb\x51\xff\x64\xb7\xb2\x8a\x57\xa3\xf5\x6e\x07\x2d\xfb\x82\xda\x4b\xee\x51\x2d\x6d\x5e\xa8\x4d\xe7\x0e\xfe\x6d\xc0\x84\x43\x78\xc0\x2e\x3d\x7b\x77\x11\x50\x62\xa5\x7f\xf2\x6d\xeb\xaf\xb5\x89\xa7\xb0\x98\x27\x81\x27\xb8\x3f\xfa\xdf\x00\x00\x00\xff\xff\xf4\x3c\xe6\xde\x91\x33\x00\x00")
+var _webScreenJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x3c\x6b\x93\xdb\x36\x92\xdf\xfd\x2b\xe0\xba\xda\x90\xf4\x70\x14\x69\x1c\xfb\x52\x9e\xd8\x29\xc7\x4e\xce\xae\x8a\x1f\x65\x3b\x67\x5f\xa9\xa6\xb2\x14\x09\x49\x5c\x53\x24\x8b\xa4\x46\xa3\x4d\xe6\xbf\x5f\x77\xe3\x0d\x92\x92\x66\x37\x77\x2e\xdb\x33\x02\x1a\x40\xa3\xd1\x6f\x34\x14\x6c\x5b\xce\xda\xae\xc9\xd3\x2e\xb8\xbc\x77\xef\x3a\x69\xd8\xc7\xb4\xe1\xbc\x64\x4f\xd9\x72\x5b\xa6\x5d\x5e\x95\x61\xc4\xfe\xb8\xc7\x18\xf6\xb5\xbc\x58\x42\x4f\xb7\xce\x5b\x80\x66\xec\xdb\x6f\xd9\xa7\x26\x49\xbf\xf2\x8c\xe5\x25\x4b\xca\xaa\x5b\x73\x80\x4a\x9b\xbc\xee\x26\xd0\x8f\xf0\x93\x4d\x85\x8b\x3c\xa5\x49\x18\x0b\x6e\x82\x27\x6c\x1a\x8b\xdf\xf7\xd6\xef\xfc\x9a\x97\x1d\x7c\x0e\x02\x6c\xb8\xbd\x27\x97\x5c\x56\x65\x07\x83\x03\x40\x4f\x34\xa4\xeb\xa4\xf9\x0c\x2d\x53\xbb\xe1\x95\xdf\xf0\x6b\xaf\xe5\xdd\x72\xd9\xf2\xee\x7f\xec\xe6\x55\x93\x67\x9f\xfd\x06\x77\xe0\xb6\x69\xab\xe6\x4b\xbf\xc9\x99\x67\xb1\x7a\x91\xa4\x6b\xda\xe3\xad\x6a\xab\x93\x82\x77\x9d\xdb\xb6\x68\xb6\xed\xda\x69\x69\x78\xcd\x93\x6e\x60\x74\x7e\xc3\x8b\x0f\x09\x90\x1f\x9a\x77\x79\x99\x55\xbb\x49\xc6\xaf\xf3\x94\xbf\x37\x3d\x7f\xfe\xc9\x66\x6a\x40\xdb\x25\xb4\xd8\x54\x9d\xcb\x2b\x9e\xaf\xd6\x1d\xab\x96\x70\x5a\x9c\x75\xc9\x82\x2d\x00\x2c\x59\x54\xd7\x9c\x5a\x70\xab\x13\x39\xb8\xb2\x49\x23\x86\xff\x57\x51\x2d\x92\x82\x7d\x61\x69\x55\x35\x59\x5e\xe2\xec\xcb\xaa\x61\x59\x93\x00\x3a\x2b\xb6\x2d\x33\xde\x00\x29\x8a\x96\xed\xd6\x15\x9c\x2f\x2f\xb3\x96\x6d\x38\xef\x60\x4f\xab\xa4\xc9\x0a\xde\xb6\x30\xb1\x98\x6d\xb7\x4e\x3a\x96\x26\xe5\x75\x8b\x6b\xef\x83\x86\xd3\x44\x25\xab\x4a\x85\xc3\xea\x8b\x5e\x5e\x9d\x04\x34\x94\x7c\xc7\x7e\xcb\xcb\xee\xfb\xe7\x4d\x93\xec\xc3\xd9\x45\x44\x10\x8a\x35\x59\x1b\x96\x91\x64\xac\x86\x77\xdb\xa6\x64\x25\x7b\x60\x51\xef\x52\xb1\x92\x1e\xd1\xf0\x36\xff\x27\x7f\x01\xb8\x24\x6d\x98\xd2\x8f\x98\xed\x62\xb6\x56\xf3\x88\xb6\xc9\x2e\xcf\x3a\x3c\xab\x36\xdc\x45\x97\x76\xc7\x5a\x10\x16\x7b\xd6\x6e\x4f\xdb\xed\x0b\xae\x07\xee\xd8\x19\x0b\xea\x9b\x60\x00\x44\x4f\xb1\xb6\x60\x5c\x34\x37\x70\x4e\x1e\x92\x37\x31\xdb\x0f\xa2\x2a\x26\x2d\xf8\x12\xa7\xbc\x19\x5f\xb6\xab\x6a\x00\xd8\x3b\x00\xe3\xe4\x90\x38\xc1\xff\x78\x08\xa1\xa7\x0d\xe4\x29\xc1\x7c\x59\x95\x6e\x37\x20\xba\x13\xd0\x1b\xc0\x25\x3f\x17\x1c\x3f\x85\x41\x5b\x27\x65\x20\x09\xb4\x9a\x00\x7f\x3d\xef\x40\xcf\x2c\xb6\x1d\x0f\x83\xb4\x48\xda\x36\x88\x59\xb0\x2a\xf6\xf5\xda\x82\x22\x3c\xb3\xbc\xad\x8b\x64\x8f\x52\x9f\x97\x45\x5e\xf2\xf3\x45\x51\xa5\x5f\x03\x17\xea\x9a\x37\x5d\x9e\x26\xc5\xf3\x22\x5f\xa1\xba\x0a\x16\x09\xa8\x1b\x80\x96\x70\x1a\xaf\x45\x95\xed\x27\x49\x5d\x03\x87\xbe\x58\xe7\x45\x16\xae\xe4\x7a\xa4\x5e\x8c\x7c\xad\x78\xf7\xa2\xda\xd4\x80\x60\xf6\x11\x57\xd0\x70\x52\x05\xd5\x49\xd3\xf2\x5f\x8a\x2a\xe9\xc2\xe5\x04\xdb\x3e\x02\xe5\x22\x41\x4d\x16\xc0\x4f\xd1\xfa\x4b\xb2\xc9\x8b\x3d\xb1\x29\x22\xdb\xf1\x1b\x98\xb6\xec\xb8\x50\x63\x5f\xd4\xc1\x48\x2d\xb6\x9a\x08\xe1\xfb\x8c\x5c\x63\xba\x5e\x59\x5d\x42\x92\xf5\x84\x62\xf7\xb4\x3e\xfe\x46\x54\xea\x92\x22\x4f\x3d\xfa\x20\xc4\x67\xc5\x68\xc1\xa2\x2a\x32\x6f\xe9\x37\x49\xb7\x9e\x6c\x92\x9b\x90\x1a\x62\x17\x95\xc8\xc5\xc5\x81\x7d\x15\x7b\xb8\x45\x1e\x72\x78\x0a\xaf\xf4\xd2\x33\x6b\xdd\x5f\x47\x37\xe6\xaa\x68\x5a\xaf\xa9\x40\xcb\x84\x62\x49\x76\x2e\xc7\x47\xec\x5b\x76\x21\x98\x33\x0a\x23\xa3\xed\x8a\x9a\x37\xa4\xa1\x88\x0d\x51\x45\x09\x6e\xe6\xed\xc4\x96\x2b\xc1\xa4\x92\xdf\x93\xae\x03\xcd\x1b\x33\xc2\x1a\xf8\x3e\x29\xea\x75\x62\xf3\xb7\x98\xe2\x00\x93\x0b\x80\x60\x48\x0f\xd4\x55\x9b\xd3\x92\x40\x82\x25\x28\xa4\x6c\x5c\x24\x83\xe9\xb0\xc0\x4a\x91\x96\xdd\x92\x17\x1b\x16\x92\x85\x68\x60\x28\x18\x5d\x81\xbb\x42\xda\x9d\x60\x8e\x40\x57\xa8\xaa\x08\x48\x7c\x14\xeb\xdc\xde\x33\x9b\xec\x6e\x00\x44\x8e\x23\x21\x28\x91\x69\xc3\xe0\x22\x03\x11\xfd\x23\x20\xaa\x80\x61\xbe\x7f\x9f\x7e\xbb\x55\xa7\x9d\x2f\x99\xa4\xa0\x59\x7d\x5c\xe8\xc4\xfc\x91\xb3\x3a\xac\x3c\xc9\x37\xc9\x8a\x7f\xdc\x54\xe0\x3a\xc0\xa1\xfd\x5c\x26\x8b\x82\xa3\xe6\x5f\x26\x45\xcb\x1d\x9a\x48\x3c\xbb\x9b\x4b\x5b\xdf\x8b\xce\x4b\xdb\x65\xd8\x24\x39\x52\xdd\x39\xea\xae\xd9\x72\xdc\xcc\x3f\x5f\x83\xe1\x42\x17\x64\x76\x1b\xb9\x16\xfd\xe8\x88\x0b\xd0\x57\x75\x05\xc6\x88\x37\x3f\xa3\xaf\xd2\xa2\xb3\x52\x56\xa0\x70\x6e\x35\x1f\x7e\xda\x55\xe8\xfe\x24\x5d\xba\xd6\xfc\xc7\xda\x0a\xac\x1e\x18\x40\xf8\x5b\xf0\xa4\xed\x58\x07\x50\x8a\x23\x5b\x84\x83\xcd\xa0\x3d\x55\x43\xc5\x5c\x44\x99\x16\x47\x25\xac\xcb\x37\x5c\x59\xca\xdf\x25\xd4\x6b\xdb\x05\x51\x8d\xd0\x36\x77\xb6\x11\xc5\xee\xb6\xa2\x2b\xed\x8d\x6c\x97\x4b\xde\xdb\xb6\xde\xca\x47\x39\xa1\x04\x43\xc6\xeb\x9a\xa4\x04\xb5\xde\xa0\x2e\x13\x18\xb7\x1a\x29\xe2\x8d\x8f\x43\x98\xd9\x3d\x3d\xf4\xe8\x98\x81\xcc\xb7\x31\x43\x82\x23\x76\x72\x5c\xc6\x17\xdb\xd5\x8b\xaa\xa8\x1a\x14\xc0\x39\x9d\xf9\xbc\x59\x2d\xc2\xe9\x4d\xc6\xd3\xe5\xc3\x25\xec\x0c\x3e\x26\xfa\x73\xcc\xa6\x93\x47\xd1\x55\x6c\x43\x3e\x9e\x2e\xb2\xc7\xdf\x1b\x48\xf1\x79\x08\x72\x39\x7b\xf4\xfd\xa3\xef\x0c\xa4\xf8\x3c\x04\xf9\x28\x4b\xe0\xaf\x81\x14\x9f\x87\x20\x17\x17\xff\xf9\x78\x71\x61\x20\xc5\x67\x0b\x52\x9f\x05\x6d\xf6\x03\x4f\x3b\xda\xab\xdb\x8c\x6e\xd0\xb6\x28\x34\x61\x84\xcc\xf1\x4c\x30\xa1\x86\x27\x5f\x3b\xc9\x44\xf3\xaf\x79\x0b\x16\x87\x4e\x57\x1b\xee\x32\xd9\x00\xa5\xd7\x49\x09\xbe\x59\x03\x5c\x91\xd4\x20\x40\x5c\xc9\xae\x91\x66\x35\xf3\x24\x47\xc6\x7f\xb7\xa4\x81\x11\xbb\xff\xf4\x29\x3b\x9f\x19\x51\x07\x16\x79\x57\x16\x7b\x50\x9a\x45\xb5\x63\xb0\x30\xea\x5c\x72\xe2\x59\x21\x57\x07\x07\xb0\x4c\x89\x6f\x8d\xc0\x3a\x0a\xc0\x5b\xb0\x06\xf7\x58\xac\x26\xa0\x50\x90\x7b\x5b\x72\xf7\x31\x59\x00\x96\x21\xee\x3d\x32\x5b\x22\x6d\xe0\x39\x8a\x82\xff\x42\xdb\x7d\xd2\x82\x74\x76\x66\x1c\x02\x68\x03\xa2\xa9\xae\xb9\x11\xb6\xbf\xe9\x46\xd0\xcb\xe5\xaa\x5b\x5f\x0d\x78\x50\x00\x61\xb9\x4f\x5a\x49\x41\x73\xdf\xc5\x93\xb3\x3d\x77\x71\x72\xe4\x68\x00\x31\xbb\x7f\xee\x49\xdd\xdf\xdc\xee\x7f\x0f\x4f\xe2\x27\xb4\xb9\xe8\xe6\xf4\xc3\x41\x3d\x66\x2e\xdd\x07\x32\xd3\x57\x86\xf4\x34\x5e\x18\xfa\x03\xa3\x65\xe8\xe1\x0d\x43\xef\xff\xd8\xb2\x14\xbc\xc5\x22\x64\xf3\x97\x85\x29\xfd\xe1\x36\x91\x91\xd7\x77\x0c\xf8\x59\x04\x80\xdf\x7c\x03\x8e\xb8\xfc\xf4\xca\x30\xf8\x00\xbf\x3a\x34\x44\xee\x04\x22\x42\xbc\x21\x29\xb0\x96\xbf\xbe\x8a\x06\x28\x2e\xd4\xe8\x28\xbc\xc6\x8b\x44\xde\x46\xc2\x9a\x83\xfa\x0e\x2e\x29\xf1\x54\x81\xed\xee\x52\x7f\x44\xc7\x6b\xed\x91\x29\x05\x73\xe4\xa8\x88\x3c\x03\x27\x28\x66\x4b\x58\x64\x01\xff\xda\xda\x10\xbd\x1f\xa7\xb2\x5e\x8c\xcb\xbc\x08\xd7\x9c\xc6\x7b\x01\x39\xb0\x80\x0b\xa7\x63\x84\x16\x40\x65\x9f\xa0\x1c\x9a\xff\x49\x9b\x5c\xf3\xb0\xdf\xbc\xcc\x8b\x42\x39\xc3\x84\xc1\x64\xb1\x1a\x04\x42\x0d\x1b\x4e\x41\x01\xc7\xaa\x87\xa2\x35\xfd\x69\xad\xdc\x59\x6f\x2c\x9c\x42\x57\x35\x3c\x3c\x70\x50\xf4\x51\x07\x7f\xd6\x27\x75\x30\x3d\x95\x84\x36\xc2\x8f\x5e\x83\xff\xc0\x40\x22\x0c\xa6\xf4\x07\x7f\x2f\xc1\x47\xfc\x08\x44\x29\x57\xe1\xec\x71\x14\x4d\xda\xed\xa2\xed\x9a\xf0\xfc\x71\xa4\x25\x15\xfd\x8e\x77\x2f\xdf\x3d\x61\xbf\xb5\xa0\xf1\xd3\xaa\x5c\xe6\xab\x6d\x83\x8e\x14\xe0\xb1\x4c\xb6\x45\x07\x04\x67\xa0\x2f\x81\xe6\x8e\x33\xbc\x5c\xfd\xee\x20\x81\xdb\x2a\xd9\x0f\x6c\xea\x0b\x01\xe0\xb5\xa4\x3f\x81\xd9\x8d\xee\x13\x53\xf4\xf5\xdb\xe2\x0e\xb3\xcb\xfd\xde\x61\xf6\xb6\x76\x67\x07\x22\x7c\xf9\xf2\xe5\x09\x7b\x59\xa1\xc7\x55\xf3\x34\x4f\x0a\xb0\x4d\xbb\x64\xdf\x6a\x2a\x74\xe0\x76\xad\x7e\xb4\x67\xd7\x14\x18\x48\x14\xa0\xcd\x06\x01\x4f\xfc\x23\xa2\x0e\x3a\xa6\x92\x3d\x7b\xc6\xe0\x54\x30\x06\x8c\xa9\x45\x34\x7d\x1f\xb1\x6f\x18\xb8\x10\x4b\xab\xa7\xec\x35\x25\xf8\x5b\x14\xb8\x0a\xd7\xc8\xca\x49\x72\x29\x25\x70\x9e\x67\x57\x3a\xc3\xc6\x58\x00\x76\xb5\x01\xef\x34\x89\x55\xc3\x72\x05\x1f\xe5\x66\x97\xab\x48\xb7\x2f\xb0\x5d\x1e\xd4\xc2\x6a\x6f\x6b\x68\x97\x24\x86\xd5\x44\xfb\x6d\x5f\xcb\x1a\x79\x75\xd1\xb5\x83\xa8\x05\xc5\xce\x1a\xcf\x61\x45\x21\xc4\x16\xf1\x46\x19\xa6\x5f\xec\x8e\xb6\xa6\xe6\xb6\xbe\xd4\xac\x14\x0a\x28\x20\x6b\xb9\xd9\xde\x4c\x10\x93\x0f\xe0\x7e\x40\x8c\x1e\x81\x32\x7f\x3a\xd0\xaa\xc8\x23\xa6\x5c\xae\x68\x4a\xa5\x29\x98\xd6\x1c\xd4\xbc\x94\xcd\xb7\x8c\x83\x83\x3a\x3c\x74\x39\x3c\x54\xcd\x38\x20\xf2\x40\xb1\x5f\x20\xba\x0a\x41\xa7\x58\x8c\xa5\xf2\x10\x18\xb1\x5b\x0a\x26\x4c\xec\xbd\xfd\x04\x01\xbc\xb7\x31\xd1\xa4\x50\x5b\xaa\x30\x5f\xe4\x22\x1c\x7b\xd0\x9f\xee\x35\xa5\x0c\xbc\x09\x55\xa3\x33\xa5\x48\x2e\x0c\x4c\x4a\x4a\x55\xa4\x46\x64\x07\xb6\x60\xe8\xa8\x53\x32\x18\xc0\x06\x6e\xdf\x4f\x32\x4b\x83\xdd\x10\xfd\x06\x43\x19\x3a\x8c\x2c\x7e\x53\x09\x46\x41\x2c\x9d\xfc\xea\xcb\x80\x6f\x18\xe8\x73\x0a\x0c\x17\x9a\x3c\x60\x6c\xe5\x04\x2d\x30\x0a\x68\x0a\x88\x46\xd0\x28\x80\x73\x6b\x7a\x6c\x93\x02\xcc\xf7\xe7\x9f\x9a\x11\xfd\x40\x3c\xa7\x60\x07\x7e\xfc\x00\xe6\x96\xe5\x67\x67\x8e\x8b\xfc\x69\x9d\xb7\x0c\xfe\x6e\xdb\x2d\x38\xca\x7b\xf6\xf7\x36\x2f\xc3\x6e\xcd\x3b\x38\xfd\x07\x2c\xd9\xd4\x45\xde\x6d\x33\xfe\x77\x34\x41\x1d\xdb\x71\x4c\x8d\x56\xe8\x56\x83\xb3\x5b\xd7\xe8\x52\x2f\xf6\x66\xb6\x59\x7d\xa3\xbc\x69\xc7\xa4\x61\xd2\x2f\x8f\x29\xb5\x47\x99\x13\x5c\x64\xf5\x05\x1b\xc1\x2b\x9e\xc1\xdf\x7e\xdc\x6d\x99\xb4\x03\x07\x80\x27\xf5\xff\x76\x00\x32\x41\x74\xc6\xc6\x0e\x02\xe4\x4e\x1f\x84\x12\x3e\x97\x0e\x0a\x49\x7b\x86\xa3\x3b\x45\xd6\x6f\xbd\x5d\x0e\x6a\xdb\x04\x70\x48\x0c\x0a\x42\x49\x8d\x08\x98\x26\x9f\x27\x63\x56\xbb\xb1\x82\x27\xd2\xfb\x88\x4c\x6b\x91\x19\x5a\x52\xb4\x0f\x2c\x79\x58\xc6\x2c\x55\x36\x40\xb9\x17\x70\x62\xf6\xd8\xd4\x23\x1c\xcc\xf6\x97\x32\x09\xae\x62\xfb\x60\xf7\x77\x66\x4b\xe0\xd0\x8b\xeb\x10\x57\x41\xd9\xae\xe1\x10\xcb\x08\x6f\x50\xe4\x44\x1f\xe0\xe6\x1d\x8f\x7c\x90\x47\x55\x9a\xf2\x5c\x26\x22\x87\x18\x55\xb9\xb5\x8e\xbe\x77\x81\x3f\x61\x92\x2d\xa5\xe5\xa7\xf6\x7a\x7d\x7e\x25\x6b\x2b\x28\xfe\x81\x1c\x71\x9e\xe1\x60\xdb\xe4\xc2\x3c\x14\xae\xc7\x0c\x82\x3e\xdb\xb0\x60\xce\x2c\xa4\x2e\x08\x0f\x29\x2e\x88\x54\xf8\x60\x62\xcb\xbd\x4a\xb9\x2e\x8b\xaa\x6a\x24\xf8\xb7\x2e\xf8\x2b\x03\xfe\x15\x93\x46\x20\xa7\xda\x1a\x9e\x19\x0b\x78\x66\x29\x4a\x05\x5f\x27\x88\xab\x15\x43\xcc\xbf\x5e\x99\xde\x14\x4f\xce\x43\x08\x9b\x60\x23\xb8\xf4\x4e\x25\x97\xf1\x82\xe8\xc6\x3e\x7c\x98\xd6\x1c\xbf\x09\x91\x55\xa8\x9f\x5a\x87\x69\x73\x3d\x31\x2d\x00\x4d\xe8\x54\xe4\xf9\xc7\x96\x4c\xc7\x7a\x63\xb1\xde\x57\xac\xb7\xa5\x67\x13\xc9\x19\x08\x1e\x75\xf2\x46\xea\x1f\xbd\xb0\x38\x58\x01\x2d\x68\x20\x97\x95\x69\xe4\xf7\xb0\x1a\x6f\x4a\x11\x8d\x07\x82\x3e\x81\x85\xad\x4d\x2f\x72\xa2\x3a\x87\xbf\x47\x11\x30\xeb\xcf\xfe\xa5\x18\x4a\x2f\x34\x14\x3e\xb5\xe1\x0d\x18\x95\x36\xdc\xd3\xff\x3b\xfa\x5f\x90\xf9\x78\xdc\x64\xeb\x5b\x03\xa6\x35\x4f\xe4\x39\x98\x02\xdc\x67\x75\xc9\xe7\x10\x05\xfd\x5f\xf3\x79\x2a\xe8\x28\x72\x6c\x20\x59\xfa\x86\x52\x5c\x91\xf2\x8e\x6d\xcb\x3c\xad\x32\x4e\x60\x49\xda\x61\xca\x6b\xd9\x54\x1b\xba\x61\x6d\x29\x6a\x9b\x28\x70\x11\xa2\x91\x3f\x90\x41\x8c\x52\x06\x1d\x5b\xc3\x51\x00\x5f\x55\x49\xc6\xda\x6d\x5d\x57\x4d\x87\x19\x7d\x68\xd8\xb5\x38\x13\x46\x6c\x3b\x20\x3b\x2b\x39\xcf\x30\x78\x59\x70\x35\x59\xc3\xaf\xf3\x36\x07\x3d\x30\x31\xce\x48\x8a\x97\xbd\x16\x55\xc4\x9d\x8a\xcc\xaa\xa5\x9a\xad\x0a\x95\x54\xb2\xae\x00\x48\xdc\x0c\xb9\x4e\xe5\xae\x8b\x68\x48\x36\x01\xbd\x0f\x22\x9d\x8d\xfe\x1e\xee\x88\xa8\xe1\x64\xc5\x71\x37\x5f\x39\xaf\x81\x80\xca\x33\x02\x37\xb3\x60\x74\x1f\x28\x68\xa8\xe6\x82\x30\x96\x53\x6e\x31\x2f\x61\x90\xa8\x30\x40\x33\xd9\x4e\xee\x0d\xcb\xbd\xaf\xc3\x95\xcc\xd9\x8c\xaf\xdb\x8e\x5b\x20\x05\x3a\x9e\x64\xb0\x21\x2c\x9b\xd2\xc3\x43\x1a\x03\xad\x7a\x8c\xc6\xf1\x96\x3a\xc1\xe2\x8c\x63\xa5\x6c\xcf\x90\x83\x5a\x78\x0e\x6a\x2a\x35\x6f\x3b\xcf\xaf\x14\x7f\xa0\x6a\x4d\xd9\x7d\x70\xcf\x21\x3a\xd5\x90\xee\x8a\xca\x74\xe5\x26\x13\x65\x34\xdd\xad\xcd\x5d\x6a\xd4\x21\x4d\xe0\xe9\xe2\x9d\x82\xb1\x14\x09\x56\x0b\xbc\xc6\xfb\x10\xa1\x2b\x8d\x0e\xb2\x54\x86\xb9\xca\x79\xc9\xc5\xf5\xf1\x6c\x3a\x9d\xba\x01\xd8\x02\x38\xe7\xeb\x0b\x02\x0a\x3b\xa0\x6d\xb5\xc5\xeb\x1c\x3b\x43\x21\x9b\x40\x89\x5c\x60\x4e\xd0\x4a\x56\x88\xb9\xd5\xed\x33\x88\xdf\x22\x07\xe7\x9d\xae\xa9\xe9\x53\xa1\x6e\x9e\xbd\x98\xf1\xc0\xb8\x75\x9e\x65\xbc\x0c\xfc\x78\x91\x94\x1f\xf4\xf1\x17\xea\x66\xaa\x97\x05\x25\xe7\x2e\x29\xf3\x0d\x70\x09\x10\x17\xcb\x05\xc2\x40\xac\xa4\x6f\x23\x4f\x5a\xd7\x8e\xe7\xd7\xd5\x6e\x7c\x45\xf2\x37\x45\x99\x89\xf4\x39\xdf\x80\xe6\xfb\x69\xdb\xee\xd1\xdd\xf4\x5b\xee\xba\x79\x3f\xdd\x7a\x32\xc1\x05\x97\xed\x72\xd4\x2d\xa1\xa9\x37\x9a\xd0\x4d\x84\x7d\x2d\x0a\x27\x12\x50\x17\xd2\x2a\x78\xd2\x6f\xcf\xaa\x5d\xa9\xdb\x07\xd3\xbf\x0e\xd1\x93\x2c\xd3\x14\x8f\x6d\xc6\x8a\xd9\xa3\x29\xca\xad\x61\xc5\xa8\x9f\x38\xe9\xd3\x79\x03\xb4\x53\x96\x10\xf3\x3d\xa9\xe5\x3c\x8f\xa6\x51\xc8\x0f\x5a\x1c\xcc\x59\xbb\x2e\x16\xfa\xb8\x97\x96\xce\x76\x6d\xa4\x5d\x75\x22\xf7\xb2\xfa\x12\xeb\xfa\xa0\x33\x30\xa0\x0f\x94\xf2\x4f\x7d\xfd\x46\x77\x53\x5e\xf6\x86\x52\x1b\x6e\xb6\x44\x14\x4c\x39\x99\x12\xd2\xe1\x26\xc3\xf3\x17\xa4\x78\xfa\xc9\x1d\x2f\xad\x73\xcf\xe2\x30\x69\xbc\x4c\x03\x56\x20\xec\x6d\x3c\xf0\x6c\x6c\xae\x7f\x5b\x35\x9b\xc4\x0e\xb3\xec\x46\xdb\x23\xed\xac\x60\x40\x61\xe5\xe2\xd4\x5d\x3a\x92\xe2\xaa\xae\x51\x6d\xa2\xc0\x1e\x69\x28\x5b\xad\x8a\xb0\x4c\x48\x8f\xeb\xe4\xf6\xa2\xb3\xcb\x9e\x36\x1e\x18\xb7\xeb\x27\xed\x6d\x01\xed\x85\x76\x56\xf3\x61\xfb\x3a\x4e\xe1\xd7\x25\xb8\x40\x9d\x47\x61\xd5\xe8\x29\x97\xb1\xe8\xab\x07\x60\x19\xe7\x99\x1b\x1b\x48\x22\x0f\xa2\x02\x21\x57\x91\xa4\xdc\xc3\x45\xb7\xfe\xbb\xc8\xa8\xf2\x96\x87\x82\xd0\x0f\xdd\x28\x5f\xd5\xf5\x21\x52\x3d\xea\xba\x91\xa2\xa5\x5f\xd2\xa6\x2a\x0a\x5b\xb9\xe0\xb9\x65\xbc\xe8\xe0\x18\x6f\x60\xeb\x7b\xf8\x77\x73\x01\x3f\x2f\x14\xfe\x9e\xc9\x09\x6d\xa1\x26\xed\x11\x86\x37\x17\x80\xe5\xcd\x2c\xa2\x04\x4d\xdf\xb7\xa6\xcb\xfd\x30\xdc\x23\xd4\xde\x85\x7a\xe5\x06\x76\xc0\x63\x3b\xab\x04\x4b\x54\xd9\xa5\xea\x2a\x92\xd0\x64\x0f\x9e\xea\xb0\x42\x34\xdf\xcc\x4c\xdb\x67\xd9\xb6\x9f\xb9\x70\xf7\x0e\x05\x82\x2a\x3a\x11\x32\xa8\x32\xde\x9e\x8f\x38\x94\x2e\x18\x77\xef\xd6\x9e\x33\x66\xbc\x14\x75\xdb\xa7\x88\x8d\xc0\x34\xea\x5c\x9e\x82\x1c\x7d\xf0\x36\xe9\x25\x4c\x17\x6a\xb7\x8a\xd4\xab\xb3\xb1\xe7\xee\xce\x18\x75\x7b\x88\xc8\x2b\xc3\x63\x78\xd8\x77\x58\x14\x9f\xe2\xcd\xa0\xf0\xf9\xad\x91\x03\x80\x66\xa5\x84\x3c\x33\x01\xee\xf2\xf0\xd8\x4d\xdd\x81\x3b\xba\x81\x55\xc7\xbd\x41\x6b\xcd\x5b\xb7\x7c\xe4\x53\xbe\xa1\x1a\x88\xa9\x63\x7b\x5f\xca\xaa\x0a\x2d\x1d\x99\xed\xe3\x58\x29\xf5\x81\x83\x71\x4d\xac\xde\x22\xd3\xa5\x1a\x87\x6b\x88\x1e\x8e\xd7\x10\xc9\x02\x98\xcb\x03\x17\xbf\xe2\x5a\x57\xbb\xdc\xe2\x5a\xd7\xbb\x43\x96\x25\x50\x2d\xe2\x12\x88\x9d\xca\xa2\xaa\xa0\x7f\x7a\xb6\xd6\x76\xda\x8f\x87\x45\x26\x9e\xd1\xc3\xac\x3c\x96\x11\x76\xc0\x44\xd6\x21\xb8\x2a\xc3\x5e\xce\xc4\x3b\xa1\xb3\xc5\x08\x54\xc9\x0e\xfe\x85\xca\x75\xc1\xfa\x1c\x37\xa5\x63\x26\xb1\x59\x8b\xae\x2b\x83\x61\x18\x5b\x84\xf1\x62\xae\x37\x37\xfc\xfe\xd0\xec\x63\x6c\x01\x73\x5d\xe9\xc3\x50\x70\x04\xdb\x36\x59\xc5\x8b\x71\xac\x1d\x15\xee\xd8\xa1\x21\x8d\xe0\x56\x08\x99\x99\x44\x4d\xd6\x5b\xb0\x11\x32\x16\x10\x35\x77\x62\x8a\x4b\x8f\x43\x45\x31\xd1\xc8\x0d\x95\xa5\x78\x54\xe2\x16\x55\xc1\x6b\xe4\xd8\xeb\xa4\x08\x8d\x58\xf5\xb4\x17\x21\xa7\xce\xfa\xa9\x1b\x3b\x8d\xf8\xa5\x0d\x39\x25\x14\xa0\xc6\xb2\x0e\x51\x93\xc6\xca\x88\xfa\x79\xe3\x15\x15\xa7\x3f\xc7\x52\x17\x1c\x3a\xf9\xde\xef\xc2\x3a\x5e\x2c\xbf\xe4\xef\x6a\xde\x24\xaa\x0a\x33\x03\x5a\x63\x25\x3b\x7c\x3c\xcf\x4b\xeb\x5e\xaa\xa7\x2e\xa7\x26\x9e\x1d\x0a\x5e\x77\x40\x5d\x3e\xb0\x6b\x6b\xbf\x6a\x27\xa2\xbb\xae\x6a\x23\x66\xa9\xea\x13\xc5\x6d\xf3\x66\xfe\xdd\x95\x3e\xcf\xbe\x54\x9e\x2c\x8f\x08\x88\x89\x91\xcf\xb2\x06\x61\xe6\xcc\xd0\x35\xd5\x57\xae\xd8\x37\x9d\x4f\x9d\x15\x6d\xd6\x4e\xe7\x33\xa7\xcf\x08\x67\x03\xa3\x62\xd6\x40\x7f\x74\x39\x74\x0b\x35\x23\xb7\xaa\x99\x5f\x5c\x61\xa2\x02\x7f\x7b\x78\x65\xa5\x2c\x7a\xd9\xf4\xc9\x23\x51\x0a\xd7\xc7\xf3\xf4\xe9\x3c\xf9\x61\x98\xdf\x18\xbb\xb3\x18\x48\x64\xad\x31\x07\x00\xa7\x60\x1b\x80\xfb\xbd\x12\x1c\x3f\x30\xb5\x4f\x16\x93\x6b\xf3\xde\x7c\x57\x6e\x75\xc6\x9a\x43\xf3\xa6\x86\xe0\x8d\x6a\xfb\x79\xb9\xc5\x3b\x41\xf1\x04\x02\x42\xd7\x35\x48\x3d\x15\x14\x53\xad\x68\x4e\x4f\x25\xd8\x82\x8b\xb2\xdd\x0c\xf3\x80\x62\x1e\xe1\xd7\x41\xcb\x62\x4f\x39\x35\x99\x29\x54\x35\x98\xf5\x76\x73\xa0\x54\x39\xcb\xaf\x45\x66\x00\xc0\x0e\x96\xe3\x33\xe0\xd6\x6d\x8d\x48\x5a\xf0\x07\x4a\x9a\x4d\xbf\x30\x73\xd0\xfb\xd0\x6d\xb7\x0a\xfa\xc9\xd6\x61\xef\x78\xa5\x30\x8c\x8b\xdc\xa4\xc4\x7b\x85\x90\x93\x06\xee\xf8\xa6\x8d\x11\x08\x0e\x82\x43\xc0\x0c\xc4\x20\xea\xeb\xda\x08\x58\xdf\xab\xbe\x0f\x0e\x5c\xde\xd2\x7c\x52\x90\xbd\x34\x19\x41\x42\xf7\x29\xd4\x25\x2e\x02\xd8\x31\x12\x6b\xe2\x9e\x23\x94\x3f\xc6\x7d\x1e\x22\xcc\x91\xfd\x5c\x43\xc2\xb9\xdb\x22\xcc\xe7\xf9\xd5\x64\x57\x35\x99\x36\x5c\xc8\xca\xba\xe7\x6b\x5e\x66\x78\x71\xa9\x1b\x10\x03\xdb\xab\xc1\x2d\xc2\xa4\x4d\x72\xe2\x8b\x0e\xfc\x43\xf0\xc7\xf7\x49\x60\xfd\x71\xee\x1e\xe6\x0e\xaa\xb1\x8b\xe8\xd5\xe4\x1f\xe0\x33\x85\x98\x90\x04\xfd\x91\x6f\x42\x6b\x32\xa2\x87\xcd\x3e\x34\x7b\xd4\xf3\xcf\x90\x1b\x6c\x30\x1c\xe7\xba\xa9\x58\xf3\x0c\xcc\x46\x92\x45\xcc\x66\x1e\x4b\x25\x65\xba\x06\x96\xc9\xe9\x31\x55\x03\xf6\x99\xd2\xf7\x4d\x55\x6d\x40\x4c\xb1\xfc\x35\xef\x4c\x36\x1a\x85\x52\x3f\x99\xc8\x25\xa3\x4a\xc6\x8a\xcd\x4b\x0a\x62\x57\xe1\xc2\x9d\xe3\x18\xf8\x7f\x16\x59\x61\x91\x78\x1a\x80\x60\x03\xa1\x14\x1e\xae\xec\x81\x7f\xb4\xe2\x33\xbf\x7e\xd1\x9a\x80\x16\x68\xbd\x39\xe4\xb6\x8d\x98\xca\xe7\x06\x21\xc8\x90\xf1\xc0\x6c\xee\x33\xa0\x72\x6e\x93\x18\x82\x86\x31\x58\xd8\xac\x7e\x0c\x12\x12\xaa\xca\x65\x1d\x19\x60\xa9\x0b\xfb\xe1\x8f\x74\xdf\x51\xdc\xb5\x42\x08\x95\xf8\xf7\x53\x6c\x0e\x9c\xad\x38\xf4\x10\x49\xa9\x21\x65\x80\xc8\xa4\xc8\x27\xe0\x57\x1d\xd5\x09\x36\xb0\x97\x48\xcf\x31\x35\xea\x2f\xa8\xd9\x96\x84\x05\xeb\x9a\x45\x32\x51\xc1\xd9\xb2\xa2\xa4\x58\x56\xd2\x7e\x02\xba\x0b\xec\x84\x2d\xf8\x54\xd5\xf6\xac\xcc\xed\x92\xba\xc1\x8c\x35\xf3\x5a\x8e\xa6\x37\xfd\x99\x3d\x46\x1e\xdc\x33\x6f\xde\x33\xb1\xe7\x22\x07\xe1\x95\x4f\x80\xee\x80\xc5\xe0\x12\xe7\xbd\x29\x2d\x64\xef\x39\x48\x8f\x52\x51\xe5\xc1\xfb\x84\xbc\x1d\xcb\xb0\x0f\x72\x88\x6d\x41\xc6\x2d\xd8\x98\x85\x51\x6b\x80\x3e\x29\xaf\xf3\x4d\xd0\xe2\xeb\xcb\x5a\xbc\xe5\xd0\x0f\x1f\xf1\xb9\xec\xd0\x8b\x4c\xc6\x7e\xcd\xbf\x72\x35\x50\x16\x2e\x8a\xc9\x02\x34\x85\x30\x15\xbd\x71\x8b\x85\x03\x80\x4c\xd8\x32\x91\x49\x97\x6e\x29\x69\xa8\xa0\x15\x55\x44\x80\x2b\xae\xae\x3c\x04\x39\xfa\x14\x3b\x26\x41\x0f\x7b\x0a\x0a\x1b\x77\xc4\x01\x5f\xc1\x85\xf1\x5e\x3f\xb9\x9d\xee\xe3\x27\xbf\xb7\x51\xaf\xcb\x86\xbb\x47\x4d\xa8\x0b\xe6\x3a\x2c\x6e\xdf\xdd\x9c\x16\x39\x56\xbf\x9e\xf9\x40\x4e\x63\x4b\x31\xbd\xb4\x1b\x74\x5a\xbb\x04\x7c\x3c\x38\xc7\x92\x81\xd6\x11\xe7\x16\xe3\xc1\xe1\xcd\xe8\x3a\x29\x91\x49\x14\x2f\x04\xad\x98\x2a\xb9\x4e\xf2\x82\xaa\x79\xc5\xae\x26\x56\x2a\xe3\x93\x3e\x50\x53\x09\xb2\x6d\x30\x18\x8c\x71\x3b\xe0\x1f\x55\xe5\x47\x92\x06\xc5\xd3\x6a\x93\x23\x9e\x11\x0e\x9a\x80\x46\xfc\x39\x49\xd7\xe6\xcd\x27\xb4\x82\x49\x76\x95\x1f\x2f\x4e\x76\x15\x78\x31\xc6\x46\x12\x9b\x73\xf8\x69\xb9\x42\xa0\x94\x90\x69\xc5\x13\x0f\xd4\xa0\x72\x4f\xb6\x92\x81\x39\x8f\xaa\xcf\x5b\xb3\xbe\xbb\x5d\x9c\x1d\x9f\x91\xa0\x4b\x14\xcc\xdf\x56\xec\x2d\x7c\xb8\x0a\x2c\x7c\x7b\xaf\x4e\xac\x3b\xa2\xd8\x10\x9b\x3b\x28\x4d\xea\x86\xae\x9d\x5e\x0a\xb1\xb5\xdd\x14\x75\x0c\x61\x6e\xb0\xd3\xbf\xa9\x33\x71\xdc\x98\x42\x79\x27\x76\x62\x53\xbf\xa7\xa0\x73\x92\xb1\xf6\x33\x70\x02\x7e\x94\x9c\xfe\x84\x4d\x8d\x87\xa0\xa0\x9f\xaa\x1b\x9c\x5e\xe5\xb5\xf5\xca\x4e\xba\x03\xe6\x2d\xb8\xf8\xed\xd2\xe1\x1a\x5f\x34\xe4\x0a\x3f\x2a\x4b\xcd\x9e\x38\x0a\x92\x1e\xf0\xd8\xb2\x6e\x1c\x06\x63\xf8\x47\x8b\xe9\x8f\x0d\x74\xea\xc4\x51\xd0\x1c\xdd\xfb\xa2\xda\x6c\x80\x81\xce\xa9\x56\xc0\xd7\xbc\xea\xe5\x02\xe8\xde\x46\x3c\x90\x87\x55\xe4\x5b\x79\xa9\x87\xdf\x42\x64\xc9\x65\xe0\x95\xda\x73\xc5\x70\xd0\xab\x89\xa8\xf3\xf8\xe1\xc5\xf9\x87\x67\x4f\x63\x6b\x7a\xe1\x0f\xc2\x3c\x39\xd6\x3d\x21\xd7\x2a\xe5\x9b\x6e\x32\x53\xda\x7d\x54\xff\x1a\xe8\xc3\x2a\x18\xe0\x8c\x0a\xb6\x07\x1d\xd0\xc2\x3d\x30\xad\x07\xbf\x1b\xec\xbe\x9b\x2a\x34\xc3\x23\x73\x2f\xbf\xc9\x7e\x42\x06\x39\x71\xe7\x04\x3b\xb6\x6f\xb9\x63\xf9\x28\xdc\xdf\xb7\x87\x09\xcd\xe4\xe0\xf1\x2b\xf1\xc3\xdd\x4e\xc0\x9b\x94\xa6\x30\x93\xee\xa0\x59\xba\x10\x47\x27\x55\xb0\x63\x9b\x53\xfd\x07\x51\x50\x40\xce\xbe\x0a\xb9\xaf\xf9\xd5\xe5\x50\xa5\xa6\x04\x30\x69\x3d\xb9\x8d\x31\x43\xa0\x66\xec\x1b\x83\xd4\x4d\x90\xdf\x4f\x4f\xc8\x8e\x83\x0c\x01\x2b\xa2\xaf\x02\x5e\xcf\x1e\x05\x8f\xe4\x79\x62\xd9\x13\x6c\x46\xfc\xb7\xb0\x68\x9a\xd4\x3c\xe4\x25\x96\x56\xfd\xf6\xe1\x35\x65\xf3\x4a\xa4\x63\x3a\x49\x05\xa6\x91\x73\x2d\xb2\xe0\x80\x24\x49\x15\xef\x0d\x91\x93\xd1\xec\xea\xf1\x0c\x26\x1b\x51\x34\x22\x77\x9a\x64\xd9\x89\xf7\xb0\x6a\x15\x05\x2f\xe6\x57\x49\xbe\x4b\x6b\x53\xcf\xf5\x53\x13\x5d\x01\x66\x32\x3b\x5a\xb9\x98\xbe\xa4\x13\x0d\xe2\xf6\x7f\x5b\xe2\xb7\x64\x98\xd9\xf2\x0e\x7c\x83\x7c\xd9\x09\x1f\x40\x21\x61\x61\x88\xd9\x2e\x42\x50\xad\xfa\xcd\x37\xf8\x01\xc7\x80\x0e\x16\xf8\x3f\x11\x3f\x15\xee\x33\x37\x25\x7f\xaa\xfb\x47\x95\x62\x07\xfc\x3f\x25\x84\x46\xf7\x98\x11\xc7\x93\x18\x3d\x6b\xe7\xe1\x83\x29\x74\xcc\x66\x87\x9a\xaf\xe0\xbc\x40\xdf\xd6\x1d\x4c\x94\x4e\x96\x79\xd3\x76\x58\x80\x1a\xb0\x60\x22\xca\x24\x81\x35\xb0\x9a\x0f\x7d\x84\x33\xc9\x0f\x91\xbb\x73\xa0\xf9\xc9\x0e\x0b\xc0\x1e\xdb\xb8\x5b\x6b\x23\x86\xb8\xa2\x64\x4e\x09\xbc\x0c\xe7\x4c\xf0\xfe\x39\x22\xdf\x83\x8d\x93\x04\x26\x34\x1b\x38\x99\x60\xc8\x21\x86\xab\xb5\x90\xdb\x43\xa5\x9f\xda\xf3\x2d\x64\x4d\x0d\x62\xae\x64\xbf\xad\x36\xdc\x15\x7c\x65\x6e\xef\xdf\x4f\x2f\xb5\x07\x73\xc0\x60\xa8\x49\xc7\x3c\x84\xde\x50\x9d\x84\x50\x77\x27\x6e\x96\xa0\x07\xaf\x02\x81\x93\x07\xf4\x52\x17\xbd\x5c\xc4\xad\x5b\x11\x25\x88\x61\xbb\xd8\x05\x78\x79\x05\xe8\x10\x4b\x97\x12\xbd\xe6\xd4\x81\x89\x1c\x2c\xd7\x4d\xed\xda\x0a\xa3\x7f\x07\x6a\x81\x44\xdf\xfb\xaa\x1d\x58\x04\xd5\x94\xfd\x8d\x14\xd6\xf1\x58\xcb\x19\x4f\xca\xd2\xc6\xa4\xe3\x30\x3f\x51\xb5\x9a\x21\x34\x4f\xaa\x58\x75\x0c\xc5\x5e\xb4\x6c\x10\xfd\x28\xa6\xc0\x2a\x93\x21\xaa\xe0\x17\x6a\xa0\x46\xfa\xd7\xd1\x36\x38\xa6\x6b\x0b\x73\x52\x73\x4f\xc5\xe4\x77\x42\x9d\xea\x1a\x46\x8e\xd1\x3f\x43\x7d\x81\x65\x32\x75\x53\x2c\xaa\x97\x28\x47\x16\xca\xfe\x08\xf7\xca\xcb\x67\x20\xf2\x44\xc2\xf9\x95\x75\x7f\x2a\x01\x3e\x4b\x73\x1e\x62\x91\xf1\xf9\x2c\x72\xbd\xdb\xc3\xdc\xe3\xcd\xef\x6c\x8f\xbc\x14\xb3\x3d\xe1\x54\x0d\xe5\x2b\x18\x73\x4d\xbd\xf8\x2a\x01\xa1\x32\xac\xc9\xfd\xc5\xfb\x10\xfe\xf2\x36\x0b\x1c\x8c\x17\x2d\xbb\x83\xc1\xe2\x31\x0b\xd2\x0b\xe8\x70\xb1\x4b\x77\xa3\x03\xc1\x94\x47\xb8\xcf\xc6\x6f\x1b\xbb\x55\x50\x1b\xd0\x6e\xdb\x30\xf9\x44\x8a\xb7\xe7\x29\x51\x8e\xf9\xaf\x0f\x9a\x15\x32\xde\x0d\x42\x8f\x28\xd8\xad\x3a\xf5\x06\x46\x63\x4c\x2f\xc5\xaa\x99\xf2\x70\x86\x75\x88\x86\xa7\x24\x58\x35\x3e\x27\x67\x59\x7b\x23\xfe\xf2\x54\x2b\xdd\xc0\x50\xd6\xf2\x75\xd9\x55\xff\x9d\xf3\x5d\xf8\x07\x19\x2d\x34\x59\x3c\x41\xd3\x1a\xe0\x33\x1b\x64\x36\xab\xc9\xc4\xf1\x7f\x5d\x7e\x12\x9c\xc1\x37\xe0\x1a\xea\xa4\xa1\x48\x19\x25\x2d\x2b\xab\x2e\x5f\xe6\x69\x22\xbe\x3a\x46\xd6\xd6\x2f\xaa\xae\x83\x60\x54\x98\xc2\xb4\x6a\xf0\x8b\x3f\xf2\x12\xc2\xd7\x24\xd3\x5f\xc1\x26\x41\x65\x70\xfb\x49\x7e\x0b\x9b\xc0\x28\x63\xbb\x35\x2f\x29\xe9\x28\xae\xfb\xc9\x01\xdd\xc4\x98\xa3\x12\x4e\x65\x22\x92\x8b\x2a\x94\xdd\x08\xdc\xb0\x0e\xa0\xda\x22\xab\x7d\x27\xcb\x15\xad\xce\x93\x62\x2c\x05\x7b\x38\xc6\x55\x50\xfe\x98\x03\x21\xae\x07\xd4\x58\xaa\xe4\xb3\xa5\x4a\x3c\x30\x49\xc8\xbe\xca\xf1\xe0\x74\xbc\xfc\xe8\x70\x28\xac\x86\x45\x7e\x48\x86\x54\x97\x07\x1c\x1a\x1b\x84\x6c\x0c\x52\x6c\xaa\x39\x0c\x1b\x3b\xcd\x4e\x91\x87\x11\xe2\x9e\xeb\x22\x57\xb0\x65\x53\xdc\xae\xc9\xd0\x22\xc6\x37\x46\x76\x15\x23\x5d\x2b\x89\x26\x0c\x2d\xf4\xae\xe1\x38\x3a\x5a\xcd\x4e\x20\xd9\x7b\x18\x80\xec\x15\x7b\xdc\xc5\x00\x0c\x73\x83\x5c\x25\xb0\x8c\x30\xee\xc7\xa1\x92\x27\xe8\x72\xc8\x39\x3e\x5f\x26\x58\xdb\xb8\xf6\x34\xa6\xa4\x8b\x4c\x5e\xa9\x3d\xf5\x54\xa6\xba\x2e\xfc\xb9\x69\x2a\xf9\x4e\x47\x44\x27\x2d\xd6\x6b\xee\x21\xae\xeb\xf2\xa2\x27\x4e\x13\x07\x69\xfd\xed\x3a\x01\x6f\x20\x8c\x60\xf4\xed\x3a\x48\x74\xba\xaa\xc5\xe7\x17\xf4\x45\x0e\xcd\x26\x70\x1a\x85\xfb\xfd\xbb\x58\x2e\xb0\xbd\x8d\x4e\x4a\x63\xe8\x9c\x8c\xf8\x7e\x1c\x2c\xf9\x89\x61\xbb\x51\xec\x89\xee\xb0\xbf\x44\x48\xbf\x31\x62\xdc\xbb\x18\xd1\xa4\x39\x78\xfb\x81\x65\x0f\xa2\xaa\x1d\xe9\x47\x5f\xc9\x26\xf2\x68\x31\x91\xac\xd9\x16\xa8\x59\xb4\x76\x1b\x57\x66\x62\x3a\x3b\x3d\xe7\xaa\x9a\x8f\x5d\xd2\x6d\xef\xa2\x6f\xc4\x80\x93\x94\xce\x79\x4b\xb0\x83\xc3\x8f\xeb\x1f\x07\xb2\x77\x67\x31\x04\xa4\x55\xd0\x41\xa8\x3b\x2a\x20\x31\xd6\xa4\x8b\xc4\x9e\xde\x27\x0d\x55\x93\xfd\x81\xf4\xc7\x83\xa2\x2f\x5f\xa5\xd3\x00\x2f\x4a\x7c\xa0\x43\xc2\x5f\x6f\x2f\xad\x40\xe0\x8d\x47\x77\xef\x3b\xa6\x90\x2b\x74\x61\xb1\x59\x6a\x8e\xbd\x18\x19\x61\xbf\x23\x63\x72\x6f\x5e\x49\x80\x62\x6d\x33\xc3\x44\x61\x1a\x8f\xf4\x01\xde\x43\x5d\xb4\x0b\xd1\x71\x85\xf5\x4a\x60\xd2\xac\xcb\x0d\x27\xac\xed\x30\xac\x55\xf5\x06\x2c\x88\x1c\x8e\x7e\xa2\x6d\x5b\xde\x1a\xae\xc5\x74\x72\x09\xda\x0d\xbf\xe4\x2d\x68\x41\xe4\xab\x56\xd4\xe8\xa4\x45\x9e\x7e\xa5\xa7\x6b\x9d\xc7\xb1\xaf\x72\x2c\x5b\xda\xdf\x81\x65\xe5\x88\xd3\x78\x76\x2d\x80\x87\x27\x38\xce\xb5\x2e\xa8\xe6\xb5\xc7\xa3\x20\x43\x09\x62\x0f\xf0\xae\x17\x2a\x63\x97\x29\x27\xaf\x2f\xdc\xb2\xa3\xa2\x21\x27\xf2\x2a\x8e\xde\xf8\xc7\x64\x10\x2c\x81\xf8\x26\xae\xf2\xd0\x19\x0e\x0f\xe4\x98\x7e\x80\x70\x97\xe8\xc0\x49\xcd\x9d\x6c\x23\x85\xc1\xd9\x4c\x5c\x3b\x79\xcc\x52\x4a\xf8\xa3\xf7\x68\x9b\x89\x63\x31\xfb\x87\x7e\xf0\x42\x6b\xf0\x28\x8f\xa7\x67\x46\x06\x1e\x49\x1c\x0d\x8e\x39\x96\x3c\x3a\xc2\x6c\xea\x5c\x65\x02\x60\xf4\x16\xcc\x9b\xc5\x2a\x8c\x18\xec\x32\xe5\x0f\x96\x4d\x5e\x16\xe2\x0b\x73\xfa\xb6\x18\xef\xd8\x86\x5f\x1f\xd8\xdf\x2c\x30\x70\xcb\x76\xa0\xb2\xd7\xba\x86\x53\x35\xf4\x96\x87\xa1\x8b\x84\x63\x36\x9b\xba\x2e\xc4\xed\xbd\xff\x05\xc4\xaa\xa1\x22\x53\x5c\x00\x00")
 
 func webScreenJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -190,7 +190,7 @@ func webScreenJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/screen.js", size: 13201, mode: os.FileMode(436), modTime: time.Unix(1490023625, 0)}
+	info := bindataFileInfo{name: "web/screen.js", size: 23635, mode: os.FileMode(436), modTime: time.Unix(1792312996, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,114 @@
+package nmux
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/neovim/go-client/nvim"
+	"github.com/tweekmonster/nmux/util"
+)
+
+// bufferInfo is a buffer reported by nvim.
+type bufferInfo struct {
+	Bufnr int    `msgpack:"bufnr"`
+	Name  string `msgpack:"name"`
+}
+
+// bufEnterHandler records a file buffer the process has open.
+func (p *Process) bufEnterHandler(bufnr int, name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.buffers == nil {
+		p.buffers = make(map[int]string)
+	}
+	p.buffers[bufnr] = filepath.Clean(name)
+}
+
+// bufDeleteHandler forgets a buffer that was deleted.
+func (p *Process) bufDeleteHandler(bufnr int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.buffers, bufnr)
+}
+
+// syncBuffers replaces the recorded buffers with the ones nvim has open.  It
+// must be called in a request.
+func (p *Process) syncBuffers(n *nvim.Nvim) error {
+	var bufs []bufferInfo
+	err := n.Eval(`map(filter(getbufinfo({'buflisted': 1}), `+
+		`'empty(getbufvar(v:val.bufnr, "&buftype")) && !empty(v:val.name)'), `+
+		`'{"bufnr": v:val.bufnr, "name": v:val.name}')`, &bufs)
+	if err != nil {
+		return err
+	}
+
+	buffers := make(map[int]string, len(bufs))
+	for _, b := range bufs {
+		buffers[b.Bufnr] = filepath.Clean(b.Name)
+	}
+
+	p.mu.Lock()
+	p.buffers = buffers
+	p.mu.Unlock()
+	return nil
+}
+
+// Buffers returns the paths of the files the process has open.
+func (p *Process) Buffers() []string {
+	p.mu.Lock()
+	names := make([]string, 0, len(p.buffers))
+	for _, name := range p.buffers {
+		names = append(names, name)
+	}
+	p.mu.Unlock()
+
+	sort.Strings(names)
+	return names
+}
+
+// hasBuffer returns true if the process has a file open.
+func (p *Process) hasBuffer(path string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, name := range p.buffers {
+		if name == path {
+			return true
+		}
+	}
+	return false
+}
+
+// FindBuffer returns the running process that has a file open, or nil.  path
+// must be absolute.
+func (m *ProcessManager) FindBuffer(path string) *Process {
+	path = filepath.Clean(path)
+	for _, p := range m.List() {
+		if p.IsRunning() && p.hasBuffer(path) {
+			return p
+		}
+	}
+	return nil
+}
+
+// Drop shows a file in the process, switching to its window if it's visible.
+func (p *Process) Drop(path string) error {
+	return p.do(func(n *nvim.Nvim) error {
+		return n.Command("drop " + fnameescape(path))
+	})
+}
+
+// RouteFiles finds a process that already has one of the files open and shows
+// the file in it, so the file isn't opened in a second process.  nil is
+// returned if none of the files are open.  The paths must be absolute.
+func (m *ProcessManager) RouteFiles(paths []string) *Process {
+	for _, path := range paths {
+		if p := m.FindBuffer(path); p != nil {
+			util.Print("Routing", path, "to", p.name())
+			if err := p.Drop(path); err != nil {
+				util.Print("Couldn't open", path, "in", p.name(), err)
+			}
+			return p
+		}
+	}
+	return nil
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/tweekmonster/nmux"
 	"github.com/tweekmonster/nmux/gui"
@@ -14,11 +17,26 @@ import (
 func main() {
 	server := flag.Bool("server", false, "Run as server")
 	addr := flag.String("addr", ":9999", "addr:port to listen on")
+	stateDir := flag.String("state-dir", "", "Directory to save sessions in")
+	headless := flag.Bool("headless", false, "Run nvim processes headless so they survive server restarts (requires --state-dir)")
+	saveInterval := flag.Duration("save-interval", time.Minute, "How often sessions are saved")
+	healthInterval := flag.Duration("health-interval", 10*time.Second, "How often processes are checked for responsiveness (0 disables)")
+	healthKillAfter := flag.Int("health-kill-after", 0, "Kill processes after this many failed health checks (0 disables)")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 10*time.Second, "How long to wait for clients and processes when shutting down")
+	hooksFile := flag.String("hooks", "", "JSON file with hooks to run on process events")
+	idleAfter := flag.Duration("idle-after", 0, "How long a process must be without a client to run idle hooks (0 disables)")
+	monitorActivity := flag.Bool("monitor-activity", false, "Keep processes without a client rendering so their activity, title, and mode are still tracked")
+	profilesFile := flag.String("profiles", "", "JSON file with launch profiles")
+	start := flag.String("start", "", "Comma separated profiles to start with the server")
+	profile := flag.String("profile", "", "Launch profile of the process the client connects to")
+	swap := flag.String("swap", "ask", "What to do when a file has a swap file: prompt, readonly, edit, recover, or ask the client")
+	poolSize := flag.Int("pool", 0, "Number of nvim processes to keep started for new clients")
+	reapAfter := flag.Duration("reap-after", 0, "Save and stop processes that are without a client for this long (0 disables, requires --state-dir)")
 
 	flag.Parse()
 
 	if !*server {
-		gui.Main(*addr)
+		gui.Main(*addr, *profile)
 		return
 	}
 
@@ -27,16 +45,123 @@ func main() {
 		return
 	}
 
-	listener, err := nmux.WebServer(*addr)
+	if *headless && *stateDir == "" {
+		log.Println("--headless requires --state-dir")
+		return
+	}
+
+	procs := nmux.NewProcessManager()
+	procs.Headless = *headless
+	procs.IdleAfter = *idleAfter
+	procs.MonitorActivity = *monitorActivity
+
+	var err error
+	if procs.Swap, err = nmux.ParseSwapPolicy(*swap); err != nil {
+		log.Println(err)
+		return
+	}
+
+	if *hooksFile != "" {
+		hooks, err := nmux.LoadHooks(*hooksFile)
+		if err != nil {
+			log.Println("Hooks error:", err)
+			return
+		}
+		procs.Hooks = hooks
+	}
+
+	var store *nmux.SessionStore
+	if *stateDir != "" {
+		store = nmux.NewSessionStore(*stateDir, procs)
+		store.ReapAfter = *reapAfter
+		if err := store.Restore(); err != nil {
+			log.Println("Restore error:", err)
+		}
+		store.Start(*saveInterval)
+	}
+
+	if *profilesFile != "" {
+		profiles, err := nmux.LoadProfiles(*profilesFile)
+		if err != nil {
+			log.Println("Profiles error:", err)
+			return
+		}
+		procs.SetProfiles(profiles)
+	}
+
+	if *start != "" {
+		for _, name := range strings.Split(*start, ",") {
+			if procs.Lookup(name) != nil {
+				// Restored from the state directory.
+				continue
+			}
+
+			if _, err := procs.CreateFromProfile("", name); err != nil {
+				log.Println("Couldn't start profile", name, err)
+			}
+		}
+	}
+
+	if *poolSize > 0 {
+		procs.AddPool(*poolSize, nmux.DefaultProcessOptions())
+	}
+
+	var health *nmux.HealthMonitor
+	if *healthInterval > 0 {
+		health = nmux.NewHealthMonitor(procs)
+		health.Interval = *healthInterval
+		health.KillAfter = *healthKillAfter
+		health.Start()
+	}
+
+	srv, err := nmux.WebServer(*addr, procs)
 	if err != nil {
 		log.Println("Error:", err)
+		return
 	}
 
-	signals := make(chan os.Signal)
+	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
-	<-signals
 
-	if err := listener.Close(); err != nil {
-		log.Println("Error:", err)
+	// Shutdown is refused if there are modified buffers.  A second signal forces
+	// it.
+	force := false
+	for range signals {
+		if force {
+			break
+		}
+
+		modified := procs.ModifiedBuffers()
+		if len(modified) == 0 {
+			break
+		}
+
+		log.Println("Refusing to shut down with modified buffers:")
+		for _, name := range modified {
+			log.Println("  ", name)
+		}
+		log.Println("Send the signal again to force shutdown")
+		force = true
+	}
+
+	if health != nil {
+		health.Stop()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Println("Shutdown error:", err)
+	}
+
+	if store != nil {
+		if err := store.Stop(); err != nil {
+			log.Println("Save error:", err)
+		}
+	}
+
+	if err := procs.Shutdown(ctx); err != nil {
+		log.Println("Shutdown error:", err)
 	}
 }
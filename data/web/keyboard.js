@@ -6,7 +6,7 @@ function nmuxMouseKey(e) {
 
   var charSize = this.charSize();
   var x = Math.floor(e.x / charSize[0]);
-  var y = Math.floor(e.y / charSize[1]);
+  var y = Math.floor((e.y - this.offset()) / charSize[1]);
 
   if (event.type == this.mouse.event && x == this.mouse.x && y == this.mouse.y) {
     return;
@@ -52,7 +52,7 @@ function nmuxMouseWheel(e) {
   var key = 'ScrollWheelDown';
   var charSize = this.charSize();
   var x = Math.floor(e.x / charSize[0]);
-  var y = Math.floor(e.y / charSize[1]);
+  var y = Math.floor((e.y - this.offset()) / charSize[1]);
 
   if (e.deltaY < 0) {
     key = 'ScrollWheelUp';
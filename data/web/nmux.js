@@ -4,10 +4,14 @@ new (function() {
   var sock;
   var scr = new Screen();
 
+  // UI elements that are drawn by this client instead of nvim.
+  var extensions = ['popupmenu', 'tabline', 'cmdline', 'wildmenu', 'messages'];
+
   function socketURL(s) {
     var l = window.location;
     return (l.protocol === 'https:' ? 'wss://' : 'ws://') + l.hostname
-      + ((l.port != 80 && l.port != 443) ? ':' + l.port : '') + s;
+      + ((l.port != 80 && l.port != 443) ? ':' + l.port : '') + s
+      + (l.search ? l.search + '&' : '?') + 'ext=' + extensions.join(',');
   }
 
   function debounce(func, wait, immediate) {
@@ -34,7 +38,7 @@ new (function() {
   function resize() {
     var c = scr.charSize();
     var w = Math.floor(document.body.offsetWidth / c[0]);
-    var h = Math.floor(document.body.offsetHeight / c[1]);
+    var h = Math.floor((document.body.offsetHeight - scr.offset()) / c[1]);
     var payload = [
       nmux.OpResize,
       w >> 8, w & 0xff,
@@ -161,6 +165,171 @@ new (function() {
           console.info('[Server Log]', buf.string(len));
           break;
 
+        case nmux.OpShutdown:
+          console.info('[Server Shutdown]', buf.string());
+          break;
+
+        case nmux.OpError:
+          console.error('[Server Error]', buf.string());
+          break;
+
+        case nmux.OpDialog:
+          var dialogId = buf.eint32();
+          var title = buf.string();
+          var message = buf.string();
+          var choices = [];
+          var choiceLen = buf.eint32();
+          while (choiceLen--) {
+            choices.push({key: buf.string(), label: buf.string()});
+          }
+          showDialog(dialogId, title, message, choices);
+          break;
+
+        case nmux.OpActivity:
+          var procId = buf.eint32();
+          var procName = buf.string();
+          var activity = buf.uint8() == 2 ? 'bell' : 'output';
+          console.info('[Activity]', procName, activity);
+          window.dispatchEvent(new CustomEvent('nmux-activity', {
+            detail: {id: procId, name: procName, activity: activity}
+          }));
+          break;
+
+        case nmux.OpPopupmenuShow:
+          var pumRow = buf.eint32();
+          var pumCol = buf.eint32();
+          var pumSelected = buf.eint32() - 1;
+          var items = [];
+          var itemLen = buf.eint32();
+          while (itemLen--) {
+            items.push({
+              word: buf.string(),
+              kind: buf.string(),
+              menu: buf.string(),
+              info: buf.string()
+            });
+          }
+          scr.showPopupmenu(items, pumSelected, pumRow, pumCol);
+          break;
+
+        case nmux.OpPopupmenuSelect:
+          scr.selectPopupmenu(buf.eint32() - 1);
+          break;
+
+        case nmux.OpPopupmenuHide:
+          scr.hidePopupmenu();
+          break;
+
+        case nmux.OpTabline:
+          var curTab = buf.eint32();
+          var tabs = [];
+          var tabLen = buf.eint32();
+          while (tabLen--) {
+            tabs.push({handle: buf.eint32(), name: buf.string()});
+          }
+
+          if (scr.setTabline(curTab, tabs, selectTab)) {
+            resize();
+          }
+          break;
+
+        case nmux.OpCmdlineShow:
+          var level = buf.eint32();
+          var pos = buf.eint32();
+          var indent = buf.eint32();
+          var firstc = buf.string();
+          var prompt = buf.string();
+          var content = buf.string();
+          scr.showCmdline(level, {
+            pos: pos,
+            indent: indent,
+            firstc: firstc,
+            prompt: prompt,
+            content: content,
+            special: ''
+          });
+          break;
+
+        case nmux.OpCmdlinePos:
+          scr.setCmdlinePos(buf.eint32(), buf.eint32());
+          break;
+
+        case nmux.OpCmdlineSpecialChar:
+          var level = buf.eint32();
+          var shift = buf.uint8() == 1;
+          scr.setCmdlineSpecialChar(level, buf.string(), shift);
+          break;
+
+        case nmux.OpCmdlineHide:
+          scr.hideCmdline(buf.eint32());
+          break;
+
+        case nmux.OpCmdlineBlockShow:
+          var lines = [];
+          var lineLen = buf.eint32();
+          while (lineLen--) {
+            lines.push(buf.string());
+          }
+          scr.showCmdlineBlock(lines);
+          break;
+
+        case nmux.OpCmdlineBlockAppend:
+          scr.appendCmdlineBlock(buf.string());
+          break;
+
+        case nmux.OpCmdlineBlockHide:
+          scr.showCmdlineBlock([]);
+          break;
+
+        case nmux.OpWildmenuShow:
+          var wildSelected = buf.eint32() - 1;
+          var wildItems = [];
+          var wildLen = buf.eint32();
+          while (wildLen--) {
+            wildItems.push(buf.string());
+          }
+          scr.showWildmenu(wildItems, wildSelected);
+          break;
+
+        case nmux.OpWildmenuSelect:
+          scr.selectWildmenu(buf.eint32() - 1);
+          break;
+
+        case nmux.OpWildmenuHide:
+          scr.showWildmenu([], -1);
+          break;
+
+        case nmux.OpMessageShow:
+          var kind = buf.string();
+          var msg = buf.string();
+          scr.showMessage(kind, msg, buf.uint8() == 1);
+          break;
+
+        case nmux.OpMessageClear:
+          scr.clearMessages();
+          break;
+
+        case nmux.OpMessageShowmode:
+          scr.setMessageStatus('showmode', buf.string());
+          break;
+
+        case nmux.OpMessageShowcmd:
+          scr.setMessageStatus('showcmd', buf.string());
+          break;
+
+        case nmux.OpMessageRuler:
+          scr.setMessageStatus('ruler', buf.string());
+          break;
+
+        case nmux.OpMessageHistory:
+          var history = [];
+          var historyLen = buf.eint32();
+          while (historyLen--) {
+            history.push({kind: buf.string(), content: buf.string()});
+          }
+          scr.showMessageHistory(history);
+          break;
+
         default:
           console.log('Unknown Op', op);
       }
@@ -176,6 +345,44 @@ new (function() {
     }
   }
 
+  // Asks the user to pick one of the choices and sends the key back.  An empty
+  // key accepts the server's default.
+  function showDialog(id, title, message, choices) {
+    setTimeout(function() {
+      var text = title + '\n\n' + message + '\n';
+      for (var i = 0; i < choices.length; i++) {
+        text += '\n[' + choices[i].key + '] ' + choices[i].label;
+      }
+
+      var key = window.prompt(text, choices.length ? choices[0].key : '') || '';
+      var out = new Uint8Array(key.length + 3);
+      out[0] = nmux.OpDialog;
+      out[1] = id >> 8;
+      out[2] = id & 0xff;
+      for (var j = 0; j < key.length; j++) {
+        out[j + 3] = key.charCodeAt(j);
+      }
+      sock.send(out.buffer);
+    }, 0);
+  }
+
+  function sendKeys(key) {
+    var data = new ArrayBuffer(key.length + 1);
+    var out = new Uint8Array(data);
+    out[0] = nmux.OpKeyboard;
+    for (var i = 0, l = key.length; i < l; i++) {
+      out[i + 1] = key.charCodeAt(i);
+    }
+
+    if (!sock.send(data)) {
+      sock.connect();
+    }
+  }
+
+  function selectTab(index) {
+    sendKeys('<C-\\><C-N>' + (index + 1) + 'gt');
+  }
+
   function keyHandler(e) {
     e.preventDefault();
     e.stopPropagation();
@@ -205,16 +412,7 @@ new (function() {
       return;
     }
 
-    var data = new ArrayBuffer(key.length + 1);
-    var out = new Uint8Array(data);
-    out[0] = nmux.OpKeyboard;
-    for (var i = 0, l = key.length; i < l; i++) {
-      out[i + 1] = key.charCodeAt(i);
-    }
-
-    if (!sock.send(data)) {
-      sock.connect();
-    }
+    sendKeys(key);
   }
 
   var sockInit = false;
@@ -26,6 +26,9 @@ var Screen = function() {
   var pixelRatio = window.devicePixelRatio || 1;
   var state = 0;
 
+  // Height of the tab bar above the grid.
+  var offsetY = 0;
+
   // Global X coordinate for drawing undercurls whose ends meet regardless of
   // what canvs they're drawn on.
   var gX = 0;
@@ -148,6 +151,10 @@ var Screen = function() {
     return [charW, charH];
   };
 
+  self.offset = function() {
+    return offsetY;
+  };
+
   self.gridSize = function() {
     return [gridW, gridH];
   };
@@ -418,7 +425,7 @@ var Screen = function() {
 
     var cw = charW * w;
     gX = x * charW;
-    moveCanvas(cursor, gX, y * charH, cw, charH);
+    moveCanvas(cursor, gX, offsetY + y * charH, cw, charH);
 
     var a = b.attr;
     var fg = b.fg;
@@ -561,6 +568,334 @@ var Screen = function() {
     debugRects.push([x, y, w, h, color]);
   }
 
+  // The completion menu is drawn with elements so it can be styled and
+  // scrolled by the browser.
+  var pum = document.createElement('div');
+  pum.setAttribute('class', 'glyph popupmenu');
+  pum.style.position = 'fixed';
+  pum.style.zIndex = 3;
+  pum.style.display = 'none';
+  document.body.appendChild(pum);
+
+  self.showPopupmenu = function(items, selected, row, col) {
+    pum.textContent = '';
+
+    for (var i = 0; i < items.length; i++) {
+      var item = document.createElement('div');
+      item.setAttribute('class', 'popupmenu-item');
+      item.style.height = charH + 'px';
+      item.textContent = items[i].word;
+
+      if (items[i].kind || items[i].menu) {
+        var extra = document.createElement('span');
+        extra.setAttribute('class', 'popupmenu-extra');
+        extra.textContent = [items[i].kind, items[i].menu].join(' ').trim();
+        item.appendChild(extra);
+      }
+
+      pum.appendChild(item);
+    }
+
+    // Show the menu above the anchor if there isn't room below it.
+    var rows = Math.min(items.length, Math.max(row, gridH - row - 1));
+    var top = (row + 1) * charH;
+    if (row + 1 + rows > gridH) {
+      top = (row - rows) * charH;
+    }
+
+    pum.style.left = (col * charW) + 'px';
+    pum.style.top = (offsetY + top) + 'px';
+    pum.style.maxHeight = (rows * charH) + 'px';
+    pum.style.display = 'block';
+    self.selectPopupmenu(selected);
+  };
+
+  self.selectPopupmenu = function(selected) {
+    for (var i = 0; i < pum.children.length; i++) {
+      var item = pum.children[i];
+      if (i == selected) {
+        item.classList.add('selected');
+        if (item.offsetTop < pum.scrollTop) {
+          pum.scrollTop = item.offsetTop;
+        } else if (item.offsetTop + item.offsetHeight > pum.scrollTop + pum.clientHeight) {
+          pum.scrollTop = item.offsetTop + item.offsetHeight - pum.clientHeight;
+        }
+      } else {
+        item.classList.remove('selected');
+      }
+    }
+  };
+
+  self.hidePopupmenu = function() {
+    pum.style.display = 'none';
+    pum.textContent = '';
+  };
+
+  // nvim's tabpages are drawn in a bar above the grid.  Like nvim's default
+  // 'showtabline', the bar is hidden while there's only one tab.
+  var tabline = document.createElement('div');
+  tabline.setAttribute('class', 'glyph tabline');
+  tabline.style.position = 'fixed';
+  tabline.style.top = '0px';
+  tabline.style.left = '0px';
+  tabline.style.right = '0px';
+  tabline.style.height = charH + 'px';
+  tabline.style.zIndex = 3;
+  tabline.style.display = 'none';
+  document.body.appendChild(tabline);
+
+  // Returns true if the bar was shown or hidden, which changes the grid's
+  // available height.
+  self.setTabline = function(current, tabs, onSelect) {
+    tabline.textContent = '';
+
+    tabs.forEach(function(tab, i) {
+      var el = document.createElement('span');
+      el.setAttribute('class', 'tabline-tab');
+      if (tab.handle == current) {
+        el.classList.add('selected');
+      }
+      el.textContent = tab.name || '[No Name]';
+      el.addEventListener('mousedown', function(e) {
+        e.preventDefault();
+        onSelect(i);
+      });
+      tabline.appendChild(el);
+    });
+
+    var offset = tabs.length > 1 ? charH : 0;
+    if (offset == offsetY) {
+      return false;
+    }
+
+    offsetY = offset;
+    tabline.style.display = offset ? 'block' : 'none';
+    main.style.top = offsetY + 'px';
+    if (debug) {
+      debug.style.top = offsetY + 'px';
+    }
+    return true;
+  };
+
+  // Command-lines are drawn in a palette over the top of the grid.  Nested
+  // command-lines, e.g. from <C-R>=, are drawn below their parent.
+  var cmdPalette = document.createElement('div');
+  cmdPalette.setAttribute('class', 'glyph cmdline');
+  cmdPalette.style.position = 'fixed';
+  cmdPalette.style.zIndex = 4;
+  cmdPalette.style.display = 'none';
+  document.body.appendChild(cmdPalette);
+
+  var cmdBlock = document.createElement('div');
+  cmdBlock.setAttribute('class', 'cmdline-block');
+  cmdPalette.appendChild(cmdBlock);
+
+  var cmdLines = document.createElement('div');
+  cmdPalette.appendChild(cmdLines);
+
+  var wildmenu = document.createElement('div');
+  wildmenu.setAttribute('class', 'wildmenu');
+  cmdPalette.appendChild(wildmenu);
+
+  var cmdlines = [];
+
+  function renderCmdlines() {
+    cmdLines.textContent = '';
+
+    cmdlines.forEach(function(c) {
+      if (!c) {
+        return;
+      }
+
+      // pos is a byte offset.
+      var bytes = unescape(encodeURIComponent(c.content));
+      var before = decodeURIComponent(escape(bytes.substr(0, c.pos)));
+      var after = c.content.substr(before.length);
+
+      // A special character is drawn over the character at the cursor unless
+      // it shifts the content.
+      var rest = c.special && c.shift ? after : after.substr(1);
+
+      var line = document.createElement('div');
+      line.setAttribute('class', 'cmdline-line');
+      line.style.height = charH + 'px';
+      line.appendChild(document.createTextNode(
+        c.prompt + c.firstc + ' '.repeat(c.indent) + before));
+
+      var cur = document.createElement('span');
+      cur.setAttribute('class', 'cmdline-cursor');
+      cur.textContent = c.special || after.substr(0, 1) || ' ';
+      line.appendChild(cur);
+
+      line.appendChild(document.createTextNode(rest));
+      cmdLines.appendChild(line);
+    });
+
+    var visible = cmdlines.some(function(c) { return !!c; });
+    cmdPalette.style.display = visible ? 'block' : 'none';
+    cmdPalette.style.left = (charW * 2) + 'px';
+    cmdPalette.style.right = (charW * 2) + 'px';
+    cmdPalette.style.top = (offsetY + charH) + 'px';
+  }
+
+  self.showCmdline = function(level, c) {
+    cmdlines[level - 1] = c;
+    renderCmdlines();
+  };
+
+  self.setCmdlinePos = function(level, pos) {
+    var c = cmdlines[level - 1];
+    if (c) {
+      c.pos = pos;
+      c.special = '';
+      renderCmdlines();
+    }
+  };
+
+  self.setCmdlineSpecialChar = function(level, ch, shift) {
+    var c = cmdlines[level - 1];
+    if (c) {
+      c.special = ch;
+      c.shift = shift;
+      renderCmdlines();
+    }
+  };
+
+  self.hideCmdline = function(level) {
+    cmdlines.length = Math.max(0, level - 1);
+    if (cmdlines.length === 0) {
+      self.showCmdlineBlock([]);
+      self.showWildmenu([], -1);
+    }
+    renderCmdlines();
+  };
+
+  self.showCmdlineBlock = function(lines) {
+    cmdBlock.textContent = '';
+    lines.forEach(self.appendCmdlineBlock);
+  };
+
+  self.appendCmdlineBlock = function(line) {
+    var el = document.createElement('div');
+    el.style.height = charH + 'px';
+    el.textContent = line;
+    cmdBlock.appendChild(el);
+  };
+
+  self.showWildmenu = function(items, selected) {
+    wildmenu.textContent = '';
+    items.forEach(function(item) {
+      var el = document.createElement('span');
+      el.setAttribute('class', 'wildmenu-item');
+      el.textContent = item;
+      wildmenu.appendChild(el);
+    });
+    self.selectWildmenu(selected);
+  };
+
+  self.selectWildmenu = function(selected) {
+    for (var i = 0; i < wildmenu.children.length; i++) {
+      var item = wildmenu.children[i];
+      if (i == selected) {
+        item.classList.add('selected');
+        item.scrollIntoView({block: 'nearest', inline: 'nearest'});
+      } else {
+        item.classList.remove('selected');
+      }
+    }
+  };
+
+  // Messages are shown as notifications in the bottom right corner instead of
+  // in the grid.  They're removed when nvim clears them, or after a while.
+  var messageTimeout = 4000;
+  var messages = document.createElement('div');
+  messages.setAttribute('class', 'glyph messages');
+  messages.style.position = 'fixed';
+  messages.style.right = charW + 'px';
+  messages.style.bottom = charH + 'px';
+  messages.style.zIndex = 5;
+  document.body.appendChild(messages);
+
+  function removeMessage(el) {
+    if (el.parentNode) {
+      el.parentNode.removeChild(el);
+    }
+  }
+
+  self.showMessage = function(kind, content, replace) {
+    if (replace && messages.lastChild) {
+      removeMessage(messages.lastChild);
+    }
+
+    var el = document.createElement('div');
+    el.setAttribute('class', 'message');
+    if (kind) {
+      el.classList.add('message-' + kind);
+    }
+    el.textContent = content;
+    messages.appendChild(el);
+
+    // Errors and prompts stay until nvim clears them.
+    if (kind.indexOf('err') == -1 && kind != 'confirm' && kind != 'return_prompt') {
+      setTimeout(removeMessage.bind(null, el), messageTimeout);
+    }
+  };
+
+  self.clearMessages = function() {
+    messages.textContent = '';
+  };
+
+  // The mode, pending command, and ruler are shown in the bottom right corner
+  // of the grid.
+  var messageStatus = document.createElement('div');
+  messageStatus.setAttribute('class', 'glyph message-status');
+  messageStatus.style.position = 'fixed';
+  messageStatus.style.right = '0px';
+  messageStatus.style.bottom = '0px';
+  messageStatus.style.zIndex = 5;
+  document.body.appendChild(messageStatus);
+
+  var statusParts = {showmode: '', showcmd: '', ruler: ''};
+  self.setMessageStatus = function(name, text) {
+    statusParts[name] = text;
+    messageStatus.textContent = [
+      statusParts.showmode,
+      statusParts.showcmd,
+      statusParts.ruler,
+    ].filter(function(t) { return !!t; }).join('  ');
+  };
+
+  // :messages is shown in a panel that's closed by clicking it.
+  var messageHistory = document.createElement('div');
+  messageHistory.setAttribute('class', 'glyph message-history');
+  messageHistory.style.position = 'fixed';
+  messageHistory.style.zIndex = 6;
+  messageHistory.style.display = 'none';
+  messageHistory.addEventListener('mousedown', function(e) {
+    e.preventDefault();
+    messageHistory.style.display = 'none';
+  });
+  document.body.appendChild(messageHistory);
+
+  self.showMessageHistory = function(entries) {
+    messageHistory.textContent = '';
+    entries.forEach(function(m) {
+      var el = document.createElement('div');
+      el.setAttribute('class', 'message');
+      if (m.kind) {
+        el.classList.add('message-' + m.kind);
+      }
+      el.textContent = m.content;
+      messageHistory.appendChild(el);
+    });
+
+    messageHistory.style.top = (offsetY + charH) + 'px';
+    messageHistory.style.left = (charW * 2) + 'px';
+    messageHistory.style.right = (charW * 2) + 'px';
+    messageHistory.style.display = entries.length ? 'block' : 'none';
+    messageHistory.scrollTop = messageHistory.scrollHeight;
+  };
+
   self.flush = function() {
     main.ctx.drawImage(buffer, 0, 0);
 
@@ -0,0 +1,93 @@
+package nmux
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tweekmonster/nmux/screen"
+)
+
+var ErrNoClient = errors.New("no client is attached")
+
+// DialogChoice is an option in a dialog.  Key is returned when it's chosen.
+type DialogChoice struct {
+	Key   string
+	Label string
+}
+
+// Dialog asks the attached client to choose one of the choices.  The dialog is
+// canceled with ErrTimeout if the client doesn't answer before the timeout,
+// or ErrNoClient if it detaches.
+func (p *Process) Dialog(title, message string, choices []DialogChoice, timeout time.Duration) (string, error) {
+	sink := p.Screen.Sink()
+	if sink == nil {
+		return "", ErrNoClient
+	}
+
+	reply := make(chan string, 1)
+
+	p.mu.Lock()
+	// Clients reply with 16 bit IDs.
+	p.nextDialog = p.nextDialog%0xffff + 1
+	id := p.nextDialog
+	if p.dialogs == nil {
+		p.dialogs = make(map[int]chan string)
+	}
+	p.dialogs[id] = reply
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		delete(p.dialogs, id)
+		p.mu.Unlock()
+	}()
+
+	var buf screen.StreamBuffer
+	buf.WriteOp(screen.OpDialog)
+	buf.WriteEncodedInt(id)
+	buf.WriteStringRun(title)
+	buf.WriteStringRun(message)
+	buf.WriteEncodedInt(len(choices))
+	for _, c := range choices {
+		buf.WriteStringRun(c.Key)
+		buf.WriteStringRun(c.Label)
+	}
+
+	if _, err := sink.Write(buf.Bytes()); err != nil {
+		return "", err
+	}
+
+	select {
+	case key, ok := <-reply:
+		if !ok {
+			return "", ErrNoClient
+		}
+		return key, nil
+	case <-time.After(timeout):
+		return "", ErrTimeout
+	}
+}
+
+// ReplyDialog answers a dialog.  An empty key accepts the dialog's default.
+func (p *Process) ReplyDialog(id int, key string) {
+	p.mu.Lock()
+	reply, ok := p.dialogs[id]
+	delete(p.dialogs, id)
+	p.mu.Unlock()
+
+	if ok {
+		reply <- key
+	}
+}
+
+// cancelDialogs cancels the dialogs waiting for a client that detached.
+func (p *Process) cancelDialogs() {
+	p.mu.Lock()
+	dialogs := p.dialogs
+	p.dialogs = nil
+	p.mu.Unlock()
+
+	for _, reply := range dialogs {
+		close(reply)
+	}
+}
@@ -3,6 +3,7 @@ package gui
 import (
 	"fmt"
 	"io"
+	neturl "net/url"
 	"time"
 
 	screen "github.com/tweekmonster/nmux/screen"
@@ -13,6 +14,7 @@ import (
 
 type Client struct {
 	Addr       string
+	Profile    string
 	Conn       *websocket.Conn
 	firstRun   bool
 	palette    map[int]screen.CellAttrs
@@ -24,9 +26,10 @@ type Client struct {
 	app        *App
 }
 
-func NewClient(addr string, app *App) (*Client, error) {
+func NewClient(addr, profile string, app *App) (*Client, error) {
 	c := &Client{
 		Addr:       addr,
+		Profile:    profile,
 		app:        app,
 		firstRun:   true,
 		palette:    make(map[int]screen.CellAttrs),
@@ -45,6 +48,9 @@ func NewClient(addr string, app *App) (*Client, error) {
 
 func (c *Client) Connect() error {
 	url := fmt.Sprintf("ws://%s/nmux", c.Addr)
+	if c.Profile != "" {
+		url += "?profile=" + neturl.QueryEscape(c.Profile)
+	}
 	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
 	if err != nil {
 		return err
@@ -224,6 +230,132 @@ func (c *Client) parseOps(r *StreamReader) {
 		case screen.OpLog:
 			util.Print("[Server Log]", r.ReadString())
 
+		case screen.OpShutdown:
+			util.Print("Server shutdown:", r.ReadString())
+
+		case screen.OpError:
+			util.Print("[Server Error]", r.ReadString())
+
+		case screen.OpDialog:
+			id := r.ReadEint32()
+			title := r.ReadString()
+			message := r.ReadString()
+			count := r.ReadEint32()
+			for i := 0; i < count; i++ {
+				r.ReadString() // Key
+				r.ReadString() // Label
+			}
+
+			// There's no dialog UI yet, so the server's default is used.
+			util.Print("[Server Dialog]", title, message)
+			c.ReplyDialog(id, "")
+
+		case screen.OpActivity:
+			r.ReadEint32() // Process ID
+			name := r.ReadString()
+			activity := screen.Activity(r.ReadUint8())
+			util.Print("[Activity]", name, activity)
+
+		case screen.OpPopupmenuShow:
+			r.ReadEint32() // Row
+			r.ReadEint32() // Col
+			r.ReadEint32() // Selected
+			count := r.ReadEint32()
+			for i := 0; i < count; i++ {
+				r.ReadString() // Word
+				r.ReadString() // Kind
+				r.ReadString() // Menu
+				r.ReadString() // Info
+			}
+
+			// nvim draws the popup menu for this client since it doesn't ask for
+			// the extension.
+			util.Debug("[Popupmenu] Show", count, "items")
+
+		case screen.OpPopupmenuSelect:
+			util.Debug("[Popupmenu] Select", r.ReadEint32()-1)
+
+		case screen.OpPopupmenuHide:
+			util.Debug("[Popupmenu] Hide")
+
+		case screen.OpTabline:
+			current := r.ReadEint32()
+			count := r.ReadEint32()
+			names := make([]string, 0, count)
+			for i := 0; i < count; i++ {
+				r.ReadEint32() // Handle
+				names = append(names, r.ReadString())
+			}
+
+			// nvim draws the tabline for this client.
+			util.Debug("[Tabline]", current, names)
+
+		case screen.OpCmdlineShow:
+			level := r.ReadEint32()
+			r.ReadEint32() // Pos
+			r.ReadEint32() // Indent
+			firstc := r.ReadString()
+			prompt := r.ReadString()
+			content := r.ReadString()
+
+			// nvim draws the command-line for this client.
+			util.Debug("[Cmdline]", level, prompt+firstc+content)
+
+		case screen.OpCmdlinePos:
+			r.ReadEint32() // Level
+			r.ReadEint32() // Pos
+
+		case screen.OpCmdlineSpecialChar:
+			r.ReadEint32() // Level
+			r.ReadUint8()  // Shift
+			r.ReadString() // Char
+
+		case screen.OpCmdlineHide:
+			util.Debug("[Cmdline] Hide", r.ReadEint32())
+
+		case screen.OpCmdlineBlockShow:
+			count := r.ReadEint32()
+			for i := 0; i < count; i++ {
+				r.ReadString()
+			}
+
+		case screen.OpCmdlineBlockAppend:
+			r.ReadString()
+
+		case screen.OpCmdlineBlockHide:
+
+		case screen.OpWildmenuShow:
+			r.ReadEint32() // Selected
+			count := r.ReadEint32()
+			for i := 0; i < count; i++ {
+				r.ReadString()
+			}
+
+		case screen.OpWildmenuSelect:
+			r.ReadEint32()
+
+		case screen.OpWildmenuHide:
+
+		case screen.OpMessageShow:
+			kind := r.ReadString()
+			content := r.ReadString()
+			r.ReadUint8() // Replace
+
+			// nvim draws messages for this client.
+			util.Print("[Message]", kind, content)
+
+		case screen.OpMessageClear:
+
+		case screen.OpMessageShowmode, screen.OpMessageShowcmd, screen.OpMessageRuler:
+			r.ReadString()
+
+		case screen.OpMessageHistory:
+			count := r.ReadEint32()
+			for i := 0; i < count; i++ {
+				kind := r.ReadString()
+				util.Print("[Message History]", kind, r.ReadString())
+			}
+
 		default:
 			util.Debug("Unknown Op:", op)
 		}
@@ -251,6 +383,13 @@ loop:
 	}
 }
 
+// ReplyDialog answers a dialog from the server.  An empty key accepts the
+// dialog's default.
+func (c *Client) ReplyDialog(id int, key string) {
+	msg := append([]byte{byte(screen.OpDialog), byte(id >> 8), byte(id)}, []byte(key)...)
+	c.Conn.WriteMessage(websocket.BinaryMessage, msg)
+}
+
 func (c *Client) SendInput(input string) {
 	msg := append([]byte{byte(screen.OpKeyboard)}, []byte(input)...)
 	c.Conn.WriteMessage(websocket.BinaryMessage, msg)
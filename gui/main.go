@@ -12,7 +12,9 @@ func handleWindowEvent(client *Client, win Window, event interface{}) {
 	}
 }
 
-func Main(addr string) {
+// Main runs the client.  If profile isn't empty, the client connects to the
+// process started with that launch profile.
+func Main(addr, profile string) {
 	Start(func(a *App) {
 		var client *Client
 
@@ -29,7 +31,7 @@ func Main(addr string) {
 					case StateEvent:
 						if appEvent == "started" {
 							var err error
-							client, err = NewClient(addr, a)
+							client, err = NewClient(addr, profile, a)
 							if err != nil {
 								panic(err)
 							}
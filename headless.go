@@ -0,0 +1,76 @@
+package nmux
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/neovim/go-client/nvim"
+)
+
+const socketWait = 5 * time.Second
+
+// defaultSocketDir is where headless processes create their sockets.
+func defaultSocketDir() string {
+	return filepath.Join(os.TempDir(), fmt.Sprintf("nmux-%d", os.Getuid()))
+}
+
+// socketPath returns the socket for a headless process.
+func (m *ProcessManager) socketPath(id int) string {
+	return filepath.Join(m.SocketDir, fmt.Sprintf("%d.sock", id))
+}
+
+// spawnHeadless starts nvim with --headless in its own session so it keeps
+// running after the server exits.  The server connects to it through the
+// socket in the process's Address.
+func (p *Process) spawnHeadless() (*exec.Cmd, *nvim.Nvim, error) {
+	opts := p.options()
+	sock := opts.Address
+	if err := os.MkdirAll(filepath.Dir(sock), 0700); err != nil {
+		return nil, nil, err
+	}
+
+	// A stale socket would prevent nvim from listening.
+	os.Remove(sock)
+
+	path := opts.Path
+	if path == "" {
+		path = "nvim"
+	}
+
+	cmd := exec.Command(path, p.args("--headless", "--listen", sock)...)
+	cmd.Env = opts.environ()
+	cmd.Dir = opts.Dir
+	cmd.SysProcAttr = detachedProcAttr()
+
+	// stderr isn't captured since the process would outlive the pipe.
+
+	if err := cmd.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := p.limit(cmd.Process.Pid); err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return nil, nil, err
+	}
+
+	deadline := time.Now().Add(socketWait)
+	for {
+		n, err := p.dial()
+		if err == nil {
+			p.registerHandlers(n)
+			return cmd, n, nil
+		}
+
+		if time.Now().After(deadline) {
+			cmd.Process.Kill()
+			cmd.Wait()
+			return nil, nil, err
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
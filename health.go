@@ -0,0 +1,213 @@
+package nmux
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tweekmonster/nmux/util"
+)
+
+// HealthState describes how responsive a process is.
+type HealthState uint8
+
+// Health states.
+const (
+	HealthUnknown HealthState = iota
+	HealthHealthy
+	HealthSlow
+	HealthUnresponsive
+)
+
+func (s HealthState) String() string {
+	switch s {
+	case HealthHealthy:
+		return "healthy"
+	case HealthSlow:
+		return "slow"
+	case HealthUnresponsive:
+		return "unresponsive"
+	}
+	return "unknown"
+}
+
+// MarshalText allows the state to be used in JSON responses.
+func (s HealthState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Health is the result of the last health check of a process.
+type Health struct {
+	State    HealthState   `json:"state"`
+	Latency  time.Duration `json:"latency"`
+	Checked  time.Time     `json:"checked"`
+	Failures int           `json:"failures"`
+}
+
+// Health returns the result of the last health check.
+func (p *Process) Health() Health {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.health
+}
+
+// HealthMonitor periodically pings the processes of a manager.
+type HealthMonitor struct {
+	// Time between checks.
+	Interval time.Duration
+
+	// How long to wait for a response before a process is unresponsive.
+	Timeout time.Duration
+
+	// Latency at which a process is considered slow.
+	SlowLatency time.Duration
+
+	// Kill a process after this many consecutive unresponsive checks so its
+	// restart policy can replace it.  Zero disables killing.
+	KillAfter int
+
+	manager *ProcessManager
+	mu      sync.Mutex
+	stop    chan struct{}
+
+	// Start times of pings that haven't been answered.
+	pending map[*Process]time.Time
+}
+
+// NewHealthMonitor creates a health monitor with default settings.
+func NewHealthMonitor(manager *ProcessManager) *HealthMonitor {
+	return &HealthMonitor{
+		Interval:    10 * time.Second,
+		Timeout:     5 * time.Second,
+		SlowLatency: 500 * time.Millisecond,
+		manager:     manager,
+		pending:     make(map[*Process]time.Time),
+	}
+}
+
+// Check pings all running processes once.
+func (h *HealthMonitor) Check() {
+	var wg sync.WaitGroup
+
+	for _, p := range h.manager.List() {
+		if !p.IsRunning() {
+			continue
+		}
+
+		wg.Add(1)
+		go func(p *Process) {
+			defer wg.Done()
+			h.check(p)
+		}(p)
+	}
+
+	wg.Wait()
+}
+
+// check pings a process with nvim_get_mode since it responds even when nvim is
+// showing a prompt.  A process that doesn't respond is wedged.  It isn't
+// pinged again until the previous ping is answered, but it's still counted as
+// unresponsive.
+func (h *HealthMonitor) check(p *Process) {
+	start := time.Now()
+	latency, err := h.ping(p)
+
+	p.mu.Lock()
+	health := &p.health
+	health.Checked = start
+	health.Latency = latency
+
+	switch {
+	case err != nil:
+		health.State = HealthUnresponsive
+		health.Failures++
+	case latency >= h.SlowLatency:
+		health.State = HealthSlow
+		health.Failures = 0
+	default:
+		health.State = HealthHealthy
+		health.Failures = 0
+	}
+
+	failures := health.Failures
+	p.mu.Unlock()
+
+	if err != nil {
+		util.Print("Process is unresponsive:", p.name(), err)
+	}
+
+	if h.KillAfter > 0 && failures >= h.KillAfter {
+		util.Print("Killing unresponsive process:", p.name())
+		if err := p.terminate(); err != nil {
+			util.Print("Couldn't kill", p.name(), err)
+		}
+	}
+}
+
+// ping waits up to the monitor's timeout for a process to answer a ping.
+func (h *HealthMonitor) ping(p *Process) (time.Duration, error) {
+	h.mu.Lock()
+	if started, ok := h.pending[p]; ok {
+		h.mu.Unlock()
+		return time.Since(started), ErrTimeout
+	}
+	start := time.Now()
+	h.pending[p] = start
+	h.mu.Unlock()
+
+	result := make(chan error, 1)
+	go func() {
+		// The ping returns when nvim answers or the connection is closed.
+		_, err := p.Blocking(context.Background())
+		h.mu.Lock()
+		delete(h.pending, p)
+		h.mu.Unlock()
+		result <- err
+	}()
+
+	timeout := time.NewTimer(h.Timeout)
+	defer timeout.Stop()
+
+	select {
+	case err := <-result:
+		return time.Since(start), err
+	case <-timeout.C:
+		return time.Since(start), ErrTimeout
+	}
+}
+
+// Start checks processes at the monitor's interval until Stop is called.
+func (h *HealthMonitor) Start() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.stop != nil {
+		return
+	}
+
+	h.stop = make(chan struct{})
+	go func(stop chan struct{}) {
+		ticker := time.NewTicker(h.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				h.Check()
+			}
+		}
+	}(h.stop)
+}
+
+// Stop stops checking processes.
+func (h *HealthMonitor) Stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.stop != nil {
+		close(h.stop)
+		h.stop = nil
+	}
+}
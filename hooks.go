@@ -0,0 +1,189 @@
+package nmux
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/neovim/go-client/nvim"
+	"github.com/tweekmonster/nmux/util"
+)
+
+// HookEvent is a process event that hooks can run on.
+type HookEvent string
+
+// Hook events.
+const (
+	// nvim was started or restarted and the UI is attached.
+	HookStarted HookEvent = "started"
+
+	// nvim exited.  Ex commands can't be run.
+	HookExited HookEvent = "exited"
+
+	// A client attached to the process.
+	HookAttached HookEvent = "attached"
+
+	// The client detached from the process.
+	HookDetached HookEvent = "detached"
+
+	// The process has been without a client for the manager's IdleAfter
+	// duration.
+	HookIdle HookEvent = "idle"
+)
+
+// Hook runs commands when a process event occurs.
+type Hook struct {
+	Event HookEvent `json:"event"`
+
+	// Ex commands run in nvim, e.g. "wall".
+	Commands []string `json:"commands,omitempty"`
+
+	// External command and its arguments.  The event is described by NMUX_*
+	// environment variables.
+	Exec []string `json:"exec,omitempty"`
+}
+
+// LoadHooks reads a JSON list of hooks from a file.
+func LoadHooks(filename string) ([]Hook, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var hooks []Hook
+	if err := json.Unmarshal(data, &hooks); err != nil {
+		return nil, err
+	}
+
+	for _, h := range hooks {
+		switch h.Event {
+		case HookStarted, HookExited, HookAttached, HookDetached, HookIdle:
+		default:
+			return nil, fmt.Errorf("unknown hook event: %q", h.Event)
+		}
+	}
+
+	return hooks, nil
+}
+
+// hooks returns the manager's and the process's hooks for an event.
+func (p *Process) hooks(event HookEvent) []Hook {
+	var hooks []Hook
+	for _, list := range [][]Hook{p.manager.Hooks, p.options().Hooks} {
+		for _, h := range list {
+			if h.Event == event {
+				hooks = append(hooks, h)
+			}
+		}
+	}
+	return hooks
+}
+
+// hookEnv returns the environment for external hook commands.
+func (p *Process) hookEnv(event HookEvent, exit *ExitInfo) []string {
+	p.mu.Lock()
+	pid := p.pid
+	name := p.Name
+	opts := p.Options
+	p.mu.Unlock()
+
+	env := append(os.Environ(),
+		"NMUX_EVENT="+string(event),
+		"NMUX_PROCESS_ID="+strconv.Itoa(p.ID),
+		"NMUX_PROCESS_NAME="+name,
+		"NMUX_PROCESS_DIR="+opts.Dir,
+		"NMUX_PROCESS_ADDRESS="+opts.Address,
+	)
+
+	if pid != 0 {
+		env = append(env, "NMUX_PROCESS_PID="+strconv.Itoa(pid))
+	}
+
+	if exit != nil {
+		env = append(env,
+			"NMUX_EXIT_CODE="+strconv.Itoa(exit.Code),
+			"NMUX_EXIT_ERROR="+exit.Error,
+		)
+	}
+
+	return env
+}
+
+// runHooks runs the hooks for an event in the background.  exit is only set
+// for HookExited.
+func (p *Process) runHooks(event HookEvent, exit *ExitInfo) {
+	hooks := p.hooks(event)
+	if len(hooks) == 0 || p.pooled() != nil {
+		return
+	}
+
+	env := p.hookEnv(event, exit)
+	name, dir := p.name(), p.options().Dir
+
+	go func() {
+		for _, h := range hooks {
+			if len(h.Commands) > 0 && event != HookExited {
+				err := p.do(func(n *nvim.Nvim) error {
+					for _, cmd := range h.Commands {
+						if err := n.Command(cmd); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+				if err != nil {
+					util.Print("Hook error:", name, event, err)
+				}
+			}
+
+			if len(h.Exec) > 0 {
+				cmd := exec.Command(h.Exec[0], h.Exec[1:]...)
+				cmd.Env = env
+				cmd.Dir = dir
+				if out, err := cmd.CombinedOutput(); err != nil {
+					util.Print("Hook error:", name, event, err, string(out))
+				}
+			}
+		}
+	}()
+}
+
+// startIdleTimer fires HookIdle if the process is still without a client
+// after the manager's IdleAfter duration.
+func (p *Process) startIdleTimer() {
+	after := p.manager.IdleAfter
+	if after <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.idleTimer != nil {
+		p.idleTimer.Stop()
+	}
+
+	p.idleTimer = time.AfterFunc(after, func() {
+		p.mu.Lock()
+		p.idleTimer = nil
+		p.mu.Unlock()
+
+		if p.IsRunning() && p.Screen.Sink() == nil {
+			p.runHooks(HookIdle, nil)
+		}
+	})
+}
+
+func (p *Process) stopIdleTimer() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.idleTimer != nil {
+		p.idleTimer.Stop()
+		p.idleTimer = nil
+	}
+}
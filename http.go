@@ -1,11 +1,18 @@
 package nmux
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"net"
 	"net/http"
+	"net/url"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -17,18 +24,54 @@ type tcpKeepAliveListener struct {
 	*net.TCPListener
 }
 
-var proc *Process
-
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
 }
 
+// requestHeader must be set on API requests that change the server's state.
+// Browsers only let pages on other sites set it if the server allows it with
+// CORS, which it doesn't.
+const requestHeader = "X-Nmux-Request"
+
+// sameOrigin returns false if a browser made the request from a page that
+// wasn't served by this server.  Other clients don't send Origin.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == r.Host
+}
+
+// checkRequest responds with an error and returns false if a request that
+// changes the server's state could have been made by a page on another site,
+// e.g. with a form.  Requests that only read state are allowed.
+func checkRequest(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+
+	if !sameOrigin(r) || r.Header.Get(requestHeader) == "" {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+// WebsocketWriter serializes writes to a websocket connection since they can
+// come from both the process's screen and the server.
 type WebsocketWriter struct {
 	Conn *websocket.Conn
+	mu   sync.Mutex
 }
 
-func (w WebsocketWriter) Write(p []byte) (int, error) {
+func (w *WebsocketWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
 	err := w.Conn.WriteMessage(websocket.BinaryMessage, p)
 	if err == nil {
 		return len(p), nil
@@ -37,6 +80,57 @@ func (w WebsocketWriter) Write(p []byte) (int, error) {
 	return 0, err
 }
 
+// writeError sends an error message to the client.
+func (w *WebsocketWriter) writeError(message string) error {
+	var p screen.StreamBuffer
+	p.WriteOp(screen.OpError)
+	p.WriteStringRun(message)
+
+	_, err := w.Write(p.Bytes())
+	return err
+}
+
+// writeActivity tells the client about activity in a process it may not be
+// viewing.
+func (w *WebsocketWriter) writeActivity(p *Process, activity screen.Activity) error {
+	var buf screen.StreamBuffer
+	buf.WriteOp(screen.OpActivity)
+	buf.WriteEncodedInt(p.ID)
+	buf.WriteStringRun(p.name())
+	buf.WriteByte(byte(activity))
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// shutdown sends the shutdown op and closes the connection.
+func (w *WebsocketWriter) shutdown(reason string) error {
+	var p screen.StreamBuffer
+	p.WriteOp(screen.OpShutdown)
+	p.WriteStringRun(reason)
+
+	if _, err := w.Write(p.Bytes()); err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, reason)
+	return w.Conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+}
+
+// Server serves websocket clients and the browser client.
+type Server struct {
+	server *http.Server
+
+	mu      sync.Mutex
+	clients map[*WebsocketWriter]struct{}
+	wg      sync.WaitGroup
+
+	procs  *ProcessManager
+	events chan ProcessEvent
+}
+
 func (ln tcpKeepAliveListener) Accept() (c net.Conn, err error) {
 	tc, err := ln.AcceptTCP()
 	if err != nil {
@@ -47,31 +141,228 @@ func (ln tcpKeepAliveListener) Accept() (c net.Conn, err error) {
 	return tc, nil
 }
 
-func websocketHandler(ws *websocket.Conn) {
-	util.Print("Connection from:", ws.RemoteAddr())
+// processOptions reads launch options from request parameters.  "file" can be
+// repeated.  The options start with those of the "profile" parameter's
+// profile and are overridden by the other parameters.  The executable, nvim's
+// arguments, and the environment can run commands, so they can only be set by
+// profiles.
+func processOptions(procs *ProcessManager, r *http.Request) (ProcessOptions, error) {
+	opts := DefaultProcessOptions()
+	if err := r.ParseForm(); err != nil {
+		return opts, err
+	}
+
+	if name := r.Form.Get("profile"); name != "" {
+		profile, ok := procs.Profile(name)
+		if !ok {
+			return opts, ErrNoProfile
+		}
+		opts = profile.Options()
+	}
+
+	if v := r.Form.Get("cwd"); v != "" {
+		opts.Dir = v
+	}
+
+	if v := r.Form.Get("address"); v != "" {
+		opts.Address = v
+	}
+
+	if v := r.Form.Get("workspace"); v != "" {
+		opts.Workspace = v
+	}
+
+	opts.Files = append(opts.Files, r.Form["file"]...)
+
+	var err error
+	if v := r.Form.Get("restart"); v != "" {
+		if opts.Restart, err = ParseRestartPolicy(v); err != nil {
+			return opts, err
+		}
+	}
+
+	if v := r.Form.Get("swap"); v != "" {
+		if opts.Swap, err = ParseSwapPolicy(v); err != nil {
+			return opts, err
+		}
+	}
+
+	if v := r.Form.Get("width"); v != "" {
+		if opts.Width, err = strconv.Atoi(v); err != nil {
+			return opts, err
+		}
+	}
+
+	if v := r.Form.Get("height"); v != "" {
+		if opts.Height, err = strconv.Atoi(v); err != nil {
+			return opts, err
+		}
+	}
+
+	if v := r.Form.Get("nice"); v != "" {
+		if opts.Limits.Nice, err = strconv.Atoi(v); err != nil {
+			return opts, err
+		}
+	}
+
+	if v := r.Form.Get("address_space"); v != "" {
+		if opts.Limits.AddressSpace, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return opts, err
+		}
+	}
+
+	if v := r.Form.Get("cpu_time"); v != "" {
+		if opts.Limits.CPUTime, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return opts, err
+		}
+	}
+
+	if v := r.Form.Get("memory_max"); v != "" {
+		if opts.Limits.MemoryMax, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return opts, err
+		}
+	}
+
+	if v := r.Form.Get("cpu_max"); v != "" {
+		opts.Limits.CPUMax = v
+	}
+
+	return opts, nil
+}
+
+// requestExtensions returns the UI elements that a client can draw from the
+// comma separated "ext" parameter.
+func requestExtensions(r *http.Request) []string {
+	var ext []string
+	for _, name := range strings.Split(r.URL.Query().Get("ext"), ",") {
+		for _, e := range ClientExtensions {
+			if name == e {
+				ext = append(ext, name)
+				break
+			}
+		}
+	}
+	return ext
+}
+
+// clientProcess finds the process a client requested with the "id" or "name"
+// query parameters.  A named process is created if it doesn't exist.  With the
+// "workspace" or "open" parameters, the workspace is opened and its first
+// process is used, and the "open" parameter's path is edited in it.  Without
+// parameters, the oldest running process is used.  The "file" parameters are
+// opened in a process that's used instead of started.  New processes are
+// started with the options from processOptions.
+func clientProcess(procs *ProcessManager, r *http.Request) (*Process, error) {
+	files, err := requestFiles(r)
+	if err != nil {
+		return nil, err
+	}
 
-	if proc == nil || !proc.IsRunning() {
-		util.Debug("Starting nvim process")
-		p, err := NewProcess("/tmp", 80, 20)
+	q := r.URL.Query()
+	if id := q.Get("id"); id != "" {
+		n, err := strconv.Atoi(id)
 		if err != nil {
-			util.Print("Couldn't start process:", err)
-			return
+			return nil, err
 		}
 
-		proc = p
+		if p := procs.Get(n); p != nil && p.IsRunning() {
+			openFiles(p, files)
+			return p, nil
+		}
+		return nil, ErrNoProcess
 	}
 
-	input := make(chan []byte)
+	// Files that are already open in a process are shown there instead of
+	// being opened in a second process, which would cause swap file prompts.
+	if p := procs.RouteFiles(files); p != nil {
+		return p, nil
+	}
 
-	closeInput := func() {
-		// Close the input without panicking.
-		defer func() {
-			recover()
-		}()
-		close(input)
+	// A profile's process is named after the profile unless a name is given.
+	name := q.Get("name")
+	if name == "" {
+		name = q.Get("profile")
 	}
 
+	if name != "" {
+		if p := procs.Lookup(name); p != nil {
+			openFiles(p, files)
+			return p, nil
+		}
+	} else if ws, err := requestWorkspace(procs, r); err != nil {
+		return nil, err
+	} else if ws != nil {
+		list, err := procs.OpenWorkspace(ws.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range list {
+			if p.IsRunning() {
+				editRequestPath(p, r)
+				openFiles(p, files)
+				return p, nil
+			}
+		}
+		return nil, ErrNoProcess
+	} else {
+		for _, p := range procs.List() {
+			if p.IsRunning() {
+				openFiles(p, files)
+				return p, nil
+			}
+		}
+	}
+
+	opts, err := processOptions(procs, r)
+	if err != nil {
+		return nil, err
+	}
+
+	util.Debug("Starting nvim process")
+	p, err := procs.Create(name, opts)
+	if err == ErrProcessExists {
+		// Another client created it first.
+		if p = procs.Lookup(name); p != nil {
+			openFiles(p, files)
+			return p, nil
+		}
+	}
+	return p, err
+}
+
+// openFiles shows files in a process that was used for a client instead of
+// being started with them.
+func openFiles(p *Process, files []string) {
+	for _, f := range files {
+		if err := p.Drop(f); err != nil {
+			util.Print("Couldn't open", f, "in", p.name(), err)
+		}
+	}
+}
+
+func (s *Server) websocketHandler(ws *websocket.Conn, proc *Process, ext []string) {
+	defer s.wg.Done()
+	util.Print("Connection from:", ws.RemoteAddr(), "process:", proc.Name)
+
+	writer := &WebsocketWriter{Conn: ws}
+	s.mu.Lock()
+	s.clients[writer] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, writer)
+		s.mu.Unlock()
+	}()
+
+	// The reader closes input when the client stops sending, and done is
+	// closed when the handler stops receiving.
+	input := make(chan []byte)
+	done := make(chan struct{})
+	defer close(done)
+
 	go func() {
+		defer close(input)
 		util.Debug("Starting input loop")
 	loop:
 		for {
@@ -86,25 +377,22 @@ func websocketHandler(ws *websocket.Conn) {
 			} else if err != nil {
 				util.Print("WebSocket Err:", err)
 				break loop
-			} else {
-				select {
-				case input <- data:
-				case <-time.After(time.Second):
-					// XXX: Needs investigation.  Occurs when a client reconnects and
-					// sends a key event while nvim is prompting in the command line.
-					// e.g. "swap recovery" prompt.
-					util.Print("Input deadlock?")
-					break loop
-				}
+			}
+
+			// Requests to nvim time out, so the main loop can't block for long.
+			select {
+			case input <- data:
+			case <-done:
+				break loop
 			}
 		}
 
 		util.Print("Input stopped for client", ws.RemoteAddr())
-		closeInput()
 	}()
 
-	if err := proc.Attach(WebsocketWriter{Conn: ws}); err != nil {
+	if err := proc.Attach(writer, ext); err != nil {
 		util.Print("Attach err:", err)
+		writer.writeError("Attach error: " + err.Error())
 	}
 
 mainloop:
@@ -128,40 +416,542 @@ mainloop:
 				cols := (int(data[1]) << 8) | int(data[2])
 				rows := (int(data[3]) << 8) | int(data[4])
 
-				// XXX: This is the origin point of the deadlock mentioned above.
-				if err := proc.Resize(cols, rows); err != nil {
+				// The resize is deferred if nvim is blocked by a prompt.
+				if err := proc.Resize(cols, rows); err == ErrNotRunning {
+					// The process is restarting.
+					util.Debug("Ignoring resize for", proc.Name)
+				} else if err != nil {
 					util.Print("Couldn't resize:", err)
-					break mainloop
+					writer.writeError("Couldn't resize: " + err.Error())
+				}
+			case screen.OpDialog:
+				if len(data) >= 3 {
+					id := (int(data[1]) << 8) | int(data[2])
+					proc.ReplyDialog(id, string(data[3:]))
 				}
 			case screen.OpKeyboard:
-				if proc != nil && proc.IsRunning() {
+				if proc.IsRunning() {
 					if _, err := proc.Input(string(data[1:])); err != nil {
 						util.Print("Input Error:", err)
-						break mainloop
+						writer.writeError("Input error: " + err.Error())
 					}
 				}
 			}
 		}
 	}
 
-	closeInput()
-
-	if err := proc.Detach(); err != nil {
+	if err := proc.Detach(writer); err != nil {
 		util.Print("Detach err:", err)
 	}
 
 	util.Print("Connection stopped:", ws.RemoteAddr())
 }
 
-func WebServer(addr string) (io.Closer, error) {
+type processStatus struct {
+	ID        int             `json:"id"`
+	Name      string          `json:"name"`
+	Profile   string          `json:"profile,omitempty"`
+	Workspace string          `json:"workspace,omitempty"`
+	State     ProcessState    `json:"state"`
+	Info      ProcessInfo     `json:"info"`
+	Activity  screen.Activity `json:"activity,omitempty"`
+	Health    Health          `json:"health"`
+	Usage     *ResourceUsage  `json:"usage,omitempty"`
+	LastExit  *ExitInfo       `json:"last_exit,omitempty"`
+}
+
+func newProcessStatus(p *Process) processStatus {
+	// Usage isn't available for remote processes or those that aren't running.
+	usage, _ := p.Usage()
+	opts := p.options()
+
+	return processStatus{
+		ID:        p.ID,
+		Name:      p.name(),
+		Profile:   opts.Profile,
+		Workspace: opts.Workspace,
+		State:     p.State(),
+		Info:      p.Info(),
+		Activity:  p.Activity(),
+		Health:    p.Health(),
+		Usage:     usage,
+		LastExit:  p.LastExit(),
+	}
+}
+
+// healthHandler responds with the health of each process.  The status is 503
+// if any process is unresponsive.
+func healthHandler(procs *ProcessManager, w http.ResponseWriter, r *http.Request) {
+	health := map[string]Health{}
+	code := http.StatusOK
+
+	for _, p := range procs.List() {
+		h := p.Health()
+		if h.State == HealthUnresponsive {
+			code = http.StatusServiceUnavailable
+		}
+		health[p.name()] = h
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(health); err != nil {
+		util.Print("Couldn't encode health:", err)
+	}
+}
+
+// requestFiles returns the absolute paths of the "file" parameters.  Relative
+// paths are relative to the "cwd" parameter.
+func requestFiles(r *http.Request) ([]string, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, f := range r.Form["file"] {
+		if !filepath.IsAbs(f) {
+			if cwd := r.Form.Get("cwd"); cwd != "" {
+				f = filepath.Join(cwd, f)
+			}
+		}
+
+		f, err := filepath.Abs(f)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	return files, nil
+}
+
+// buffersHandler lists the files open in each process with GET.  With the
+// "file" parameter, the process that has the file open is returned.  POST
+// opens the "file" parameters in the process that already has one of them
+// open, or in the process named by "name".
+func buffersHandler(procs *ProcessManager, w http.ResponseWriter, r *http.Request) {
+	if !checkRequest(w, r) {
+		return
+	}
+
+	files, err := requestFiles(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var result interface{}
+
+	switch r.Method {
+	case http.MethodGet:
+		if len(files) > 0 {
+			p := procs.FindBuffer(files[0])
+			if p == nil {
+				http.Error(w, ErrNoProcess.Error(), http.StatusNotFound)
+				return
+			}
+			result = newProcessStatus(p)
+			break
+		}
+
+		buffers := map[string][]string{}
+		for _, p := range procs.List() {
+			if p.IsRunning() {
+				buffers[p.name()] = p.Buffers()
+			}
+		}
+		result = buffers
+
+	case http.MethodPost:
+		if len(files) == 0 {
+			http.Error(w, "file is required", http.StatusBadRequest)
+			return
+		}
+
+		p := procs.RouteFiles(files)
+		if p == nil {
+			if p = procs.Lookup(r.FormValue("name")); p == nil || !p.IsRunning() {
+				http.Error(w, ErrNoProcess.Error(), http.StatusNotFound)
+				return
+			}
+
+			for _, f := range files {
+				if err := p.Drop(f); err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+			}
+		}
+		result = newProcessStatus(p)
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		util.Print("Couldn't encode buffers:", err)
+	}
+}
+
+// requestWorkspace returns the workspace named by the "workspace" parameter or
+// the one containing the "open" parameter's path.  A workspace is created for
+// a path inside a git repository.  nil is returned if neither parameter is
+// set.
+func requestWorkspace(procs *ProcessManager, r *http.Request) (*Workspace, error) {
+	if name := r.FormValue("workspace"); name != "" {
+		ws := procs.Workspace(name)
+		if ws == nil {
+			return nil, ErrNoWorkspace
+		}
+		return ws, nil
+	}
+
+	if path := r.FormValue("open"); path != "" {
+		return procs.WorkspaceForPath(path)
+	}
+
+	return nil, nil
+}
+
+// editRequestPath edits the "open" parameter's path in a process of the
+// workspace that contains it.
+func editRequestPath(p *Process, r *http.Request) {
+	path := r.FormValue("open")
+	if path == "" {
+		return
+	}
+
+	path, err := filepath.Abs(path)
+	if err == nil {
+		err = p.Drop(path)
+	}
+	if err != nil {
+		util.Print("Couldn't open", path, "in", p.name(), err)
+	}
+}
+
+type workspaceStatus struct {
+	*Workspace
+	Running []string `json:"running"`
+}
+
+// workspacesHandler lists workspaces with GET, creates a workspace with POST,
+// and removes a workspace with DELETE.
+func workspacesHandler(procs *ProcessManager, w http.ResponseWriter, r *http.Request) {
+	if !checkRequest(w, r) {
+		return
+	}
+
+	status := []workspaceStatus{}
+
+	switch r.Method {
+	case http.MethodGet:
+		for _, ws := range procs.Workspaces() {
+			st := workspaceStatus{Workspace: ws, Running: []string{}}
+			running := procs.workspaceProcesses(ws.Name)
+			for _, name := range ws.Names() {
+				if p := running[name]; p != nil && p.IsRunning() {
+					st.Running = append(st.Running, name)
+				}
+			}
+			status = append(status, st)
+		}
+
+	case http.MethodPost:
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		root := r.Form.Get("root")
+		if root == "" {
+			http.Error(w, "root is required", http.StatusBadRequest)
+			return
+		}
+
+		name := r.Form.Get("name")
+		if name == "" {
+			name = filepath.Base(root)
+		}
+
+		var metadata map[string]string
+		for _, m := range r.Form["meta"] {
+			i := strings.IndexByte(m, '=')
+			if i < 1 {
+				http.Error(w, fmt.Sprintf("invalid meta: %q", m), http.StatusBadRequest)
+				return
+			}
+
+			if metadata == nil {
+				metadata = make(map[string]string)
+			}
+			metadata[m[:i]] = m[i+1:]
+		}
+
+		ws, err := procs.CreateWorkspace(name, root, metadata)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		status = append(status, workspaceStatus{Workspace: ws, Running: []string{}})
+
+	case http.MethodDelete:
+		if err := procs.RemoveWorkspace(r.FormValue("name")); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		util.Print("Couldn't encode workspace list:", err)
+	}
+}
+
+// openWorkspaceHandler starts the processes of the workspace selected by the
+// "workspace" or "open" parameters and responds with their status.  The
+// "open" parameter's path is edited in the first running process.
+func openWorkspaceHandler(procs *ProcessManager, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !checkRequest(w, r) {
+		return
+	}
+
+	ws, err := requestWorkspace(procs, r)
+	if ws == nil && err == nil {
+		err = ErrNoWorkspace
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	list, err := procs.OpenWorkspace(ws.Name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for _, p := range list {
+		if p.IsRunning() {
+			editRequestPath(p, r)
+			break
+		}
+	}
+
+	status := []processStatus{}
+	for _, p := range list {
+		status = append(status, newProcessStatus(p))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		util.Print("Couldn't encode process list:", err)
+	}
+}
+
+// profilesHandler responds with the launch profiles.
+func profilesHandler(procs *ProcessManager, w http.ResponseWriter, r *http.Request) {
+	profiles := []Profile{}
+	for _, name := range procs.Profiles() {
+		if profile, ok := procs.Profile(name); ok {
+			profiles = append(profiles, profile)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(profiles); err != nil {
+		util.Print("Couldn't encode profiles:", err)
+	}
+}
+
+// processesHandler lists processes with GET, creates a process with POST, and
+// kills a process with DELETE.
+func processesHandler(procs *ProcessManager, w http.ResponseWriter, r *http.Request) {
+	if !checkRequest(w, r) {
+		return
+	}
+
+	status := []processStatus{}
+
+	switch r.Method {
+	case http.MethodGet:
+		for _, p := range procs.List() {
+			status = append(status, newProcessStatus(p))
+		}
+
+	case http.MethodPost:
+		opts, err := processOptions(procs, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		name := r.FormValue("name")
+		if name == "" {
+			name = r.FormValue("profile")
+		}
+
+		p, err := procs.Create(name, opts)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		status = append(status, newProcessStatus(p))
+
+	case http.MethodDelete:
+		id, err := strconv.Atoi(r.FormValue("id"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := procs.Kill(id); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		util.Print("Couldn't encode process list:", err)
+	}
+}
+
+// Shutdown stops accepting connections, sends the shutdown op to connected
+// clients, and waits for their handlers to finish.
+func (s *Server) Shutdown(ctx context.Context) error {
+	err := s.server.Shutdown(ctx)
+	s.procs.Unsubscribe(s.events)
+	close(s.events)
+
+	s.mu.Lock()
+	for w := range s.clients {
+		if err := w.shutdown("Server is shutting down"); err != nil {
+			util.Print("Couldn't notify client:", err)
+			w.Conn.Close()
+		}
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// broadcastActivity sends activity in processes without a client to every
+// connected client so they can point it out.
+func (s *Server) broadcastActivity() {
+	for e := range s.events {
+		if e.Activity == screen.ActivityNone {
+			continue
+		}
+
+		// Clients aren't written to with the lock held so that a slow client
+		// doesn't block others from connecting.
+		s.mu.Lock()
+		clients := make([]*WebsocketWriter, 0, len(s.clients))
+		for w := range s.clients {
+			clients = append(clients, w)
+		}
+		s.mu.Unlock()
+
+		for _, w := range clients {
+			if err := w.writeActivity(e.Process, e.Activity); err != nil {
+				util.Debug("Couldn't send activity:", err)
+			}
+		}
+	}
+}
+
+func WebServer(addr string, procs *ProcessManager) (*Server, error) {
+	s := &Server{
+		server: &http.Server{
+			Addr: addr,
+		},
+		clients: make(map[*WebsocketWriter]struct{}),
+		procs:   procs,
+		events:  make(chan ProcessEvent, 16),
+	}
+
+	procs.Subscribe(s.events)
+	go s.broadcastActivity()
+
 	http.HandleFunc("/nmux", func(w http.ResponseWriter, r *http.Request) {
+		// Processes are started before the websocket's origin is checked by
+		// the upgrader.
+		if !sameOrigin(r) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		proc, err := clientProcess(procs, r)
+		if err != nil {
+			util.Print("Couldn't get process:", err)
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		s.wg.Add(1)
 		ws, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
+			s.wg.Done()
 			util.Print("Couldn't upgrade connection for", r.RemoteAddr)
 			return
 		}
 
-		websocketHandler(ws)
+		s.websocketHandler(ws, proc, requestExtensions(r))
+	})
+
+	http.HandleFunc("/api/processes", func(w http.ResponseWriter, r *http.Request) {
+		processesHandler(procs, w, r)
+	})
+
+	http.HandleFunc("/api/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		workspacesHandler(procs, w, r)
+	})
+
+	http.HandleFunc("/api/workspaces/open", func(w http.ResponseWriter, r *http.Request) {
+		openWorkspaceHandler(procs, w, r)
+	})
+
+	http.HandleFunc("/api/buffers", func(w http.ResponseWriter, r *http.Request) {
+		buffersHandler(procs, w, r)
+	})
+
+	http.HandleFunc("/api/profiles", func(w http.ResponseWriter, r *http.Request) {
+		profilesHandler(procs, w, r)
+	})
+
+	http.HandleFunc("/api/health", func(w http.ResponseWriter, r *http.Request) {
+		healthHandler(procs, w, r)
 	})
 
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -182,22 +972,18 @@ func WebServer(addr string) (io.Closer, error) {
 		w.Write(data)
 	})
 
-	server := &http.Server{
-		Addr: addr,
-	}
-
-	listener, err := net.Listen("tcp", server.Addr)
+	listener, err := net.Listen("tcp", s.server.Addr)
 	if err != nil {
 		return nil, err
 	}
 
-	util.Debug("Listening on", server.Addr)
+	util.Debug("Listening on", s.server.Addr)
 
 	go func() {
-		if err := server.Serve(tcpKeepAliveListener{listener.(*net.TCPListener)}); err != nil {
+		if err := s.server.Serve(tcpKeepAliveListener{listener.(*net.TCPListener)}); err != nil && err != http.ErrServerClosed {
 			util.Print("Server Error:", err)
 		}
 	}()
 
-	return listener, nil
+	return s, nil
 }
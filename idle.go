@@ -0,0 +1,216 @@
+package nmux
+
+import (
+	"time"
+
+	"github.com/neovim/go-client/nvim"
+	"github.com/tweekmonster/nmux/util"
+)
+
+// ClientExtensions are the UI elements that clients can draw themselves.
+// nvim only leaves an element to the client if the attached client says it
+// can draw it.
+var ClientExtensions = []string{
+	"popupmenu",
+	"tabline",
+	"cmdline",
+	"wildmenu",
+	"messages",
+}
+
+// uiOptions returns the options for attaching the UI with the client's
+// extensions.  The line based grid protocol is used if nvim supports it.  nvim
+// versions that don't report the options they support only get the options
+// that predate it.  It must be called in a request.
+func uiOptions(n *nvim.Nvim, ext map[string]bool) (map[string]interface{}, error) {
+	var info []interface{}
+	if err := n.Request("nvim_get_api_info", &info); err != nil {
+		return nil, err
+	}
+
+	var supported []interface{}
+	if len(info) > 1 {
+		if metadata, ok := info[1].(map[string]interface{}); ok {
+			supported, _ = metadata["ui_options"].([]interface{})
+		}
+	}
+
+	opts := map[string]interface{}{
+		"rgb": true,
+	}
+
+	if supported == nil {
+		opts["popupmenu_external"] = ext["popupmenu"]
+		return opts, nil
+	}
+
+	isSupported := func(name string) bool {
+		for _, s := range supported {
+			if s == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	if isSupported("ext_linegrid") {
+		opts["ext_linegrid"] = true
+	}
+
+	// ext_messages implies ext_linegrid, which is supported by every nvim that
+	// supports ext_messages.
+	for _, name := range ClientExtensions {
+		if ext[name] && isSupported("ext_"+name) {
+			opts["ext_"+name] = true
+		}
+	}
+
+	return opts, nil
+}
+
+// sameExtensions returns true if two sets of extensions are the same.
+func sameExtensions(a, b map[string]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name := range a {
+		if !b[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// attachUI attaches the screen to nvim.  nvim responds with a full redraw, so
+// the screen is resynced with nvim's state.  It must be called in a request.
+func (p *Process) attachUI(n *nvim.Nvim) error {
+	p.mu.Lock()
+	ext := p.clientExt
+	p.mu.Unlock()
+
+	opts, err := uiOptions(n, ext)
+	if err != nil {
+		return err
+	}
+
+	p.Screen.ResetExternal()
+	err = n.AttachUI(p.Screen.Size.X, p.Screen.Size.Y, opts)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.uiAttached = true
+	p.uiExt = ext
+	p.mu.Unlock()
+	return nil
+}
+
+// detachUI detaches the screen from nvim.  It must be called in a request.
+func (p *Process) detachUI(n *nvim.Nvim) error {
+	if !p.isUIAttached() {
+		return nil
+	}
+
+	if err := n.DetachUI(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.uiAttached = false
+	p.uiExt = nil
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *Process) isUIAttached() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.uiAttached
+}
+
+// suspendUI detaches the UI while there's no client so nvim doesn't spend time
+// rendering a screen no one is looking at.  The sink is checked in the request
+// since a client may attach while it's queued.
+func (p *Process) suspendUI() {
+	if !p.IsRunning() {
+		return
+	}
+
+	err := p.do(func(n *nvim.Nvim) error {
+		if p.Screen.Sink() != nil {
+			return nil
+		}
+		return p.detachUI(n)
+	})
+	if err != nil {
+		util.Print("Couldn't suspend UI for", p.name(), err)
+	}
+}
+
+// resumeUI reattaches a suspended UI.  The UI is also reattached if it was
+// attached with extensions that the client can't draw, or without ones it
+// can.  Resizes that were requested while it was suspended are applied
+// afterwards.
+func (p *Process) resumeUI() error {
+	if !p.IsRunning() {
+		return nil
+	}
+
+	err := p.do(func(n *nvim.Nvim) error {
+		p.mu.Lock()
+		attached := p.uiAttached
+		same := sameExtensions(p.uiExt, p.clientExt)
+		p.mu.Unlock()
+
+		if attached && same {
+			return nil
+		}
+
+		if err := p.detachUI(n); err != nil {
+			return err
+		}
+		return p.attachUI(n)
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.applyResize()
+}
+
+// setIdle marks the process as being without a client since now, or as having
+// one if idle is false.
+func (p *Process) setIdle(idle bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !idle {
+		p.idleSince = time.Time{}
+	} else if p.idleSince.IsZero() {
+		p.idleSince = time.Now()
+	}
+}
+
+// IdleSince returns the time the process's last client detached, or the time
+// it started if no client attached.  It's zero while a client is attached.
+func (p *Process) IdleSince() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.idleSince
+}
+
+// idle is called when the process is left without a client.
+func (p *Process) idle() {
+	// Pooled processes stay attached so they can be handed out instantly.
+	if p.pooled() != nil {
+		return
+	}
+
+	p.setIdle(true)
+	// Activity can't be seen without a UI.
+	if !p.manager.MonitorActivity {
+		p.suspendUI()
+	}
+	p.startIdleTimer()
+}
@@ -0,0 +1,77 @@
+package nmux
+
+import "github.com/neovim/go-client/nvim"
+
+// ProcessInfo describes what a process is doing so that clients can label it.
+type ProcessInfo struct {
+	// nvim's working directory.
+	Cwd string `json:"cwd"`
+
+	// Full path of the current buffer.  It's empty for unnamed buffers.
+	Buffer string `json:"buffer"`
+
+	// Whether the current buffer has unsaved changes.
+	Modified bool `json:"modified"`
+
+	// Title set by nvim when 'title' is set.
+	Title string `json:"title"`
+
+	// Name of the current mode, e.g. "normal" or "insert".
+	Mode string `json:"mode"`
+
+	// Whether nvim is waiting for command-line input, e.g. a prompt.
+	Cmdline bool `json:"cmdline"`
+}
+
+// bufferState is the current buffer reported by nvim.
+type bufferState struct {
+	Cwd      string `msgpack:"cwd"`
+	Buffer   string `msgpack:"buffer"`
+	Modified int    `msgpack:"modified"`
+}
+
+// cwdHandler records nvim's working directory after it changes.
+func (p *Process) cwdHandler(cwd string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.info.Cwd = cwd
+}
+
+// bufferHandler records the current buffer and its modified state.
+func (p *Process) bufferHandler(name string, modified int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.info.Buffer = name
+	p.info.Modified = modified != 0
+}
+
+// syncInfo gets the working directory and current buffer from nvim.  It must
+// be called in a request.
+func (p *Process) syncInfo(n *nvim.Nvim) error {
+	var st bufferState
+	err := n.Eval(`{'cwd': getcwd(), 'buffer': expand('%:p'), 'modified': &modified}`, &st)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.info.Cwd = st.Cwd
+	p.info.Buffer = st.Buffer
+	p.info.Modified = st.Modified != 0
+	p.mu.Unlock()
+	return nil
+}
+
+// Info returns a snapshot of what the process is doing.  The title, mode, and
+// command-line state aren't updated while the process's UI is suspended.
+func (p *Process) Info() ProcessInfo {
+	p.mu.Lock()
+	info := p.info
+	p.mu.Unlock()
+
+	if p.Screen != nil {
+		info.Title, info.Mode = p.Screen.Status()
+		info.Cmdline = p.Screen.CmdlineActive()
+	}
+	return info
+}
@@ -0,0 +1,170 @@
+package nmux
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tweekmonster/nmux/util"
+)
+
+// usageInterval is the minimum time between the samples used to calculate
+// CPU usage.
+const usageInterval = time.Second
+
+var ErrLimitsUnsupported = errors.New("resource limits aren't supported on this platform")
+var ErrInvalidCgroup = errors.New("cgroup must be a relative path inside the cgroup mount")
+
+// ResourceLimits are applied to nvim when it's started.  Zero values aren't
+// applied.  Processes that are connected to instead of started aren't limited.
+type ResourceLimits struct {
+	// Maximum size of the process's virtual memory in bytes (RLIMIT_AS).
+	AddressSpace uint64 `json:"address_space,omitempty"`
+
+	// Maximum CPU time in seconds (RLIMIT_CPU).
+	CPUTime uint64 `json:"cpu_time,omitempty"`
+
+	// Scheduling priority.
+	Nice int `json:"nice,omitempty"`
+
+	// cgroup v2 group, relative to the cgroup mount, that the process is placed
+	// in.  Each process gets its own child group so the limits below apply to
+	// nvim and its jobs together.  It's skipped if cgroup v2 isn't available.
+	// It's only accepted from profiles since the server writes to the group.
+	Cgroup string `json:"cgroup,omitempty"`
+
+	// Value of the process's memory.max when placed in a cgroup.
+	MemoryMax uint64 `json:"memory_max,omitempty"`
+
+	// Value of the process's cpu.max when placed in a cgroup, e.g.
+	// "50000 100000" for half of a CPU.
+	CPUMax string `json:"cpu_max,omitempty"`
+}
+
+// ResourceUsage is the current resource usage of a process.
+type ResourceUsage struct {
+	// Total user and system CPU time.
+	CPU time.Duration `json:"cpu"`
+
+	// Percentage of a CPU used since the previous sample.
+	CPUPercent float64 `json:"cpu_percent"`
+
+	// Resident set size in bytes.
+	RSS uint64 `json:"rss"`
+}
+
+// usageSample is the CPU time of a process at a point in time.
+type usageSample struct {
+	time    time.Time
+	cpu     time.Duration
+	percent float64
+}
+
+// checkCgroup returns ErrInvalidCgroup if a cgroup would be outside of the
+// cgroup mount.
+func checkCgroup(cgroup string) error {
+	if cgroup == "" {
+		return nil
+	}
+
+	if filepath.IsAbs(cgroup) || strings.Contains(cgroup, "..") {
+		return ErrInvalidCgroup
+	}
+
+	// cgroups aren't available on platforms without a mount.
+	dir := filepath.Join(cgroupRoot, cgroup)
+	if cgroupRoot != "" && !strings.HasPrefix(dir, cgroupRoot+string(filepath.Separator)) {
+		return ErrInvalidCgroup
+	}
+
+	return nil
+}
+
+// cgroupDir returns the process's cgroup directory, or an empty string if it
+// isn't placed in a cgroup.
+func (p *Process) cgroupDir() (string, error) {
+	cgroup := p.options().Limits.Cgroup
+	if cgroup == "" {
+		return "", nil
+	}
+
+	if err := checkCgroup(cgroup); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cgroupRoot, cgroup, fmt.Sprintf("nmux-%d", p.ID)), nil
+}
+
+// limit applies the process's resource limits to a newly started nvim.
+// Failing to place the process in a cgroup isn't an error since cgroups may
+// not be available or delegated to the server's user.
+func (p *Process) limit(pid int) error {
+	limits := p.options().Limits
+	if err := applyLimits(pid, limits); err != nil {
+		return err
+	}
+
+	dir, err := p.cgroupDir()
+	if err == nil && dir != "" {
+		err = joinCgroup(dir, pid, limits)
+	}
+
+	if err != nil {
+		util.Print("Couldn't place", p.name(), "in cgroup:", err)
+	}
+
+	return nil
+}
+
+// setPid sets the ID of the running nvim process.
+func (p *Process) setPid(pid int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pid = pid
+	p.usage = usageSample{}
+}
+
+// Usage returns the current resource usage of nvim.  Only processes running
+// on this host can be measured.
+func (p *Process) Usage() (*ResourceUsage, error) {
+	p.mu.Lock()
+	pid := p.pid
+	p.mu.Unlock()
+
+	if pid == 0 {
+		return nil, ErrNotRunning
+	}
+
+	cpu, rss, err := readUsage(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	usage := &ResourceUsage{CPU: cpu, RSS: rss}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Samples that are too close together would give erratic percentages, so
+	// the last percentage is reused until the interval has passed.
+	prev := p.usage
+	if !prev.time.IsZero() && now.Sub(prev.time) < usageInterval {
+		usage.CPUPercent = prev.percent
+		return usage, nil
+	}
+
+	if !prev.time.IsZero() && cpu >= prev.cpu {
+		usage.CPUPercent = 100 * float64(cpu-prev.cpu) / float64(now.Sub(prev.time))
+	}
+
+	p.usage = usageSample{
+		time:    now,
+		cpu:     cpu,
+		percent: usage.CPUPercent,
+	}
+
+	return usage, nil
+}
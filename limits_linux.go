@@ -0,0 +1,139 @@
+package nmux
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+const (
+	cgroupRoot = "/sys/fs/cgroup"
+
+	// USER_HZ, which is 100 on all supported architectures.
+	clockTicks = 100
+)
+
+// rlimit64 is the struct used by prlimit64 on all architectures.
+type rlimit64 struct {
+	Cur uint64
+	Max uint64
+}
+
+// prlimit sets both the soft and hard limit of a resource for another
+// process.  It's used since Go can't set limits between fork and exec.
+func prlimit(pid, resource int, value uint64) error {
+	lim := rlimit64{Cur: value, Max: value}
+	_, _, errno := syscall.RawSyscall6(syscall.SYS_PRLIMIT64, uintptr(pid),
+		uintptr(resource), uintptr(unsafe.Pointer(&lim)), 0, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// applyLimits sets the rlimits and nice level of a process.
+func applyLimits(pid int, limits ResourceLimits) error {
+	if limits.AddressSpace > 0 {
+		if err := prlimit(pid, syscall.RLIMIT_AS, limits.AddressSpace); err != nil {
+			return fmt.Errorf("couldn't limit address space: %v", err)
+		}
+	}
+
+	if limits.CPUTime > 0 {
+		if err := prlimit(pid, syscall.RLIMIT_CPU, limits.CPUTime); err != nil {
+			return fmt.Errorf("couldn't limit CPU time: %v", err)
+		}
+	}
+
+	if limits.Nice != 0 {
+		if err := syscall.Setpriority(syscall.PRIO_PROCESS, pid, limits.Nice); err != nil {
+			return fmt.Errorf("couldn't set nice level: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// joinCgroup moves a process into a cgroup v2 group, creating it if needed,
+// and sets the group's limits.
+func joinCgroup(dir string, pid int, limits ResourceLimits) error {
+	if _, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers")); err != nil {
+		return errors.New("cgroup v2 isn't available")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	if limits.MemoryMax > 0 {
+		value := strconv.FormatUint(limits.MemoryMax, 10)
+		if err := ioutil.WriteFile(filepath.Join(dir, "memory.max"), []byte(value), 0644); err != nil {
+			return err
+		}
+	}
+
+	if limits.CPUMax != "" {
+		if err := ioutil.WriteFile(filepath.Join(dir, "cpu.max"), []byte(limits.CPUMax), 0644); err != nil {
+			return err
+		}
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, "cgroup.procs"), []byte(strconv.Itoa(pid)), 0644)
+}
+
+// removeCgroup removes a process's cgroup.  It fails if any of the process's
+// jobs are still running in it.
+func removeCgroup(dir string) error {
+	return syscall.Rmdir(dir)
+}
+
+// readUsage reads the CPU time and resident set size of a process from
+// /proc/<pid>/stat.
+func readUsage(pid int) (time.Duration, uint64, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// The command name can contain spaces and parentheses, so the fields start
+	// after the last parenthesis.
+	i := bytes.LastIndexByte(data, ')')
+	if i == -1 {
+		return 0, 0, errors.New("malformed stat")
+	}
+
+	// Fields following the command name, starting with the state (field 3).
+	fields := bytes.Fields(data[i+1:])
+	if len(fields) < 22 {
+		return 0, 0, errors.New("malformed stat")
+	}
+
+	utime, err := strconv.ParseUint(string(fields[11]), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	stime, err := strconv.ParseUint(string(fields[12]), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	rss, err := strconv.ParseInt(string(fields[21]), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	cpu := time.Duration(utime+stime) * time.Second / clockTicks
+	if rss < 0 {
+		rss = 0
+	}
+
+	return cpu, uint64(rss) * uint64(os.Getpagesize()), nil
+}
@@ -0,0 +1,27 @@
+//go:build !linux
+// +build !linux
+
+package nmux
+
+import "time"
+
+const cgroupRoot = ""
+
+func applyLimits(pid int, limits ResourceLimits) error {
+	if limits.AddressSpace > 0 || limits.CPUTime > 0 || limits.Nice != 0 {
+		return ErrLimitsUnsupported
+	}
+	return nil
+}
+
+func joinCgroup(dir string, pid int, limits ResourceLimits) error {
+	return ErrLimitsUnsupported
+}
+
+func removeCgroup(dir string) error {
+	return ErrLimitsUnsupported
+}
+
+func readUsage(pid int) (time.Duration, uint64, error) {
+	return 0, 0, ErrLimitsUnsupported
+}
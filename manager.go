@@ -0,0 +1,385 @@
+package nmux
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tweekmonster/nmux/screen"
+	"github.com/tweekmonster/nmux/util"
+)
+
+var ErrProcessExists = errors.New("process name already exists")
+var ErrNoProcess = errors.New("no such process")
+var ErrNoProfile = errors.New("no such profile")
+
+// ProcessState is the lifecycle state of a managed process.
+type ProcessState uint8
+
+// Process states.
+const (
+	StateStarting ProcessState = iota
+	StateRunning
+	StateRestarting
+	StateExited
+)
+
+func (s ProcessState) String() string {
+	switch s {
+	case StateStarting:
+		return "starting"
+	case StateRunning:
+		return "running"
+	case StateRestarting:
+		return "restarting"
+	case StateExited:
+		return "exited"
+	}
+	return "unknown"
+}
+
+// MarshalText allows the state to be used in JSON responses.
+func (s ProcessState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ProcessEvent is sent to subscribers when a process changes state, or when
+// there's activity on the screen of a process without a client.  Activity is
+// ActivityNone for state changes.
+type ProcessEvent struct {
+	Process  *Process
+	State    ProcessState
+	Activity screen.Activity
+}
+
+// ProcessManager owns a set of named nvim processes.
+type ProcessManager struct {
+	// Start all processes with the Headless option.
+	Headless bool
+
+	// Directory for the sockets of headless processes.
+	SocketDir string
+
+	// Swap policy for processes that don't have one.
+	Swap SwapPolicy
+
+	// Hooks run for every process, before the process's own hooks.
+	Hooks []Hook
+
+	// How long a process must be without a client before HookIdle runs.  Zero
+	// disables idle hooks.
+	IdleAfter time.Duration
+
+	// Keep the UIs of processes without a client attached so activity on
+	// their screens can be reported.
+	MonitorActivity bool
+
+	mu         sync.Mutex
+	nextID     int
+	procs      map[int]*Process
+	listeners  map[chan<- ProcessEvent]struct{}
+	register   *register
+	pools      []*WarmPool
+	profiles   map[string]Profile
+	workspaces map[string]*Workspace
+	sessions   *SessionStore
+
+	// The process the register was yanked in, and the channel that wakes the
+	// register worker when the register changes.
+	registerFrom *Process
+	registerWake chan struct{}
+}
+
+// NewProcessManager creates an empty process manager.
+func NewProcessManager() *ProcessManager {
+	m := &ProcessManager{
+		SocketDir:  defaultSocketDir(),
+		procs:      make(map[int]*Process),
+		listeners:  make(map[chan<- ProcessEvent]struct{}),
+		workspaces: make(map[string]*Workspace),
+	}
+	m.registerWake = make(chan struct{}, 1)
+	go m.shareRegisters()
+	return m
+}
+
+// Create starts a new process.  If name is empty, the process ID is used as
+// its name.  A process with a workspace joins it and starts in its root unless
+// the options have a directory.  A process that was reaped is restored with
+// its saved session and options when its name is used again.
+func (m *ProcessManager) Create(name string, opts ProcessOptions) (*Process, error) {
+	if err := checkCgroup(opts.Limits.Cgroup); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	sessions := m.sessions
+	m.mu.Unlock()
+
+	if sessions != nil && name != "" {
+		proc, err := sessions.restoreReaped(name, opts.Files)
+		if err != nil {
+			return nil, err
+		} else if proc != nil {
+			m.joinWorkspace(proc)
+			return proc, nil
+		}
+	}
+
+	opts, err := m.workspaceOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Swap == SwapPrompt {
+		opts.Swap = m.Swap
+	}
+
+	proc, err := m.claim(name, opts)
+	if proc == nil && err == nil {
+		proc, err = m.create(0, name, opts, nil)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.joinWorkspace(proc)
+	return proc, nil
+}
+
+// create starts a process using a specific ID if id is greater than 0.  args
+// are passed to nvim in addition to the options' arguments.
+func (m *ProcessManager) create(id int, name string, opts ProcessOptions, args []string) (*Process, error) {
+	m.mu.Lock()
+	if name != "" && m.lookup(name) != nil {
+		m.mu.Unlock()
+		return nil, ErrProcessExists
+	}
+
+	if id > 0 {
+		if _, ok := m.procs[id]; ok {
+			m.mu.Unlock()
+			return nil, ErrProcessExists
+		}
+
+		if id > m.nextID {
+			m.nextID = id
+		}
+	} else {
+		m.nextID++
+		id = m.nextID
+	}
+
+	if name == "" {
+		name = strconv.Itoa(id)
+	}
+
+	if m.Headless && opts.Address == "" {
+		opts.Headless = true
+	}
+
+	if opts.Headless && opts.Address == "" {
+		opts.Address = m.socketPath(id)
+	}
+
+	proc := &Process{
+		ID:        id,
+		Name:      name,
+		Options:   opts,
+		manager:   m,
+		extraArgs: args,
+	}
+	// The process can be looked up as soon as it's added, so it must be
+	// usable by clients before then.
+	proc.init()
+	m.procs[id] = proc
+	m.mu.Unlock()
+
+	m.emit(proc, StateStarting)
+
+	if err := proc.start(); err != nil {
+		// The supervisor also sets the state, but subscribers shouldn't have to
+		// wait for it to learn that the process failed.
+		proc.setState(StateExited)
+		m.remove(proc)
+		return nil, err
+	}
+
+	return proc, nil
+}
+
+// Get returns the process with the specified ID, or nil.
+func (m *ProcessManager) Get(id int) *Process {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.procs[id]
+}
+
+// Lookup returns the process with the specified name, or nil.
+func (m *ProcessManager) Lookup(name string) *Process {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lookup(name)
+}
+
+func (m *ProcessManager) lookup(name string) *Process {
+	for _, p := range m.procs {
+		if p.name() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// List returns the managed processes ordered by ID.
+func (m *ProcessManager) List() []*Process {
+	m.mu.Lock()
+	list := make([]*Process, 0, len(m.procs))
+	for _, p := range m.procs {
+		list = append(list, p)
+	}
+	m.mu.Unlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	return list
+}
+
+// Kill stops the process with the specified ID.  It's removed from the manager
+// once it exits.
+func (m *ProcessManager) Kill(id int) error {
+	proc := m.Get(id)
+	if proc == nil {
+		return ErrNoProcess
+	}
+	return proc.Kill()
+}
+
+// ModifiedBuffers returns the modified buffers of all running processes,
+// prefixed with the process names.  Remote and headless processes are ignored
+// since they keep running when nmux stops.  A process that can't be asked,
+// e.g. because it's blocked, is listed with an unknown buffer since it may
+// have changes.
+func (m *ProcessManager) ModifiedBuffers() []string {
+	var modified []string
+
+	for _, p := range m.List() {
+		if !p.IsRunning() || p.IsRemote() || p.options().Headless {
+			continue
+		}
+
+		names, err := p.ModifiedBuffers()
+		if err != nil {
+			util.Print("Couldn't get modified buffers for", p.name(), err)
+			modified = append(modified, p.name()+": unknown ("+err.Error()+")")
+			continue
+		}
+
+		for _, name := range names {
+			modified = append(modified, p.name()+": "+name)
+		}
+	}
+
+	return modified
+}
+
+// Shutdown preserves the buffers of all processes, kills them, and waits for
+// them to exit.  Remote and headless processes are only disconnected.
+func (m *ProcessManager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	pools := m.pools
+	m.pools = nil
+	m.mu.Unlock()
+
+	for _, pool := range pools {
+		pool.Stop()
+	}
+
+	list := m.List()
+
+	for _, p := range list {
+		if p.IsRemote() || p.options().Headless {
+			if err := p.Disconnect(); err != nil && err != ErrNotRunning {
+				util.Print("Couldn't disconnect", p.name(), err)
+			}
+			continue
+		}
+
+		if err := p.Preserve(); err != nil && err != ErrNotRunning {
+			util.Print("Couldn't preserve buffers for", p.name(), err)
+		}
+
+		if err := p.Kill(); err != nil && err != ErrNotRunning {
+			util.Print("Couldn't kill", p.name(), err)
+		}
+	}
+
+	for _, p := range list {
+		if p.Deadman == nil {
+			continue
+		}
+
+		select {
+		case <-p.Deadman:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Subscribe registers a channel to receive process events.  Events are dropped
+// if the channel isn't ready to receive them.
+func (m *ProcessManager) Subscribe(ch chan<- ProcessEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listeners[ch] = struct{}{}
+}
+
+// Unsubscribe stops sending events to a channel.
+func (m *ProcessManager) Unsubscribe(ch chan<- ProcessEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.listeners, ch)
+}
+
+func (m *ProcessManager) emit(proc *Process, state ProcessState) {
+	m.send(ProcessEvent{Process: proc, State: state})
+}
+
+func (m *ProcessManager) emitActivity(proc *Process, activity screen.Activity) {
+	m.send(ProcessEvent{Process: proc, State: proc.State(), Activity: activity})
+}
+
+func (m *ProcessManager) send(e ProcessEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for ch := range m.listeners {
+		select {
+		case ch <- e:
+		default:
+			util.Debug("Dropped process event:", e.Process.Name, e.State, e.Activity)
+		}
+	}
+}
+
+func (m *ProcessManager) remove(proc *Process) {
+	if pool := proc.pooled(); pool != nil {
+		pool.discard(proc)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.procs[proc.ID] == proc {
+		delete(m.procs, proc.ID)
+	}
+}
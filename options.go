@@ -0,0 +1,104 @@
+package nmux
+
+import (
+	"os"
+	"strings"
+)
+
+// ProcessOptions configures how an nvim process is started.
+type ProcessOptions struct {
+	// Name of the profile the options came from.
+	Profile string `json:"profile,omitempty"`
+
+	// Name of the workspace the process belongs to.
+	Workspace string `json:"workspace,omitempty"`
+
+	// Path to the nvim binary.  nvim is found in $PATH if empty.
+	Path string `json:"path,omitempty"`
+
+	// Extra arguments for nvim, e.g. "-u" or "--cmd".
+	Args []string `json:"args,omitempty"`
+
+	// Files to open.
+	Files []string `json:"files,omitempty"`
+
+	// Ex commands run each time nvim starts.
+	Commands []string `json:"commands,omitempty"`
+
+	// Environment variables that override the server's environment.
+	Env map[string]string `json:"env,omitempty"`
+
+	// Address of an nvim process started elsewhere with --listen.  The process
+	// is connected to instead of started, and the options above are ignored.
+	Address string `json:"address,omitempty"`
+
+	// Start nvim with --headless and connect to it through a socket in the
+	// manager's SocketDir.  Headless processes keep running when the server
+	// stops and are reconnected to when it's restored.
+	Headless bool `json:"headless,omitempty"`
+
+	// Working directory.  The server's working directory is used if empty.
+	Dir string `json:"dir,omitempty"`
+
+	// What to do when a file has a swap file.
+	Swap SwapPolicy `json:"swap,omitempty"`
+
+	// Whether the process is restarted after it exits.
+	Restart RestartPolicy `json:"restart,omitempty"`
+
+	// Resource limits applied when nvim is started.
+	Limits ResourceLimits `json:"limits"`
+
+	// Hooks run on the process's events.
+	Hooks []Hook `json:"hooks,omitempty"`
+
+	// Initial grid size.
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// DefaultProcessOptions returns options for an 80x20 nvim process.
+func DefaultProcessOptions() ProcessOptions {
+	return ProcessOptions{
+		Width:  80,
+		Height: 20,
+	}
+}
+
+// environ returns the server's environment with the overrides applied.
+func (o ProcessOptions) environ() []string {
+	env := os.Environ()
+	if len(o.Env) == 0 {
+		return env
+	}
+
+	out := make([]string, 0, len(env)+len(o.Env))
+	for _, e := range env {
+		if i := strings.IndexByte(e, '='); i != -1 {
+			if _, ok := o.Env[e[:i]]; ok {
+				continue
+			}
+		}
+		out = append(out, e)
+	}
+
+	for k, v := range o.Env {
+		out = append(out, k+"="+v)
+	}
+
+	return out
+}
+
+// size returns the initial grid size, falling back to the defaults for
+// unusable values.
+func (o ProcessOptions) size() (int, int) {
+	def := DefaultProcessOptions()
+	w, h := o.Width, o.Height
+	if w <= 0 {
+		w = def.Width
+	}
+	if h <= 0 {
+		h = def.Height
+	}
+	return w, h
+}
@@ -0,0 +1,292 @@
+package nmux
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neovim/go-client/nvim"
+	"github.com/tweekmonster/nmux/util"
+)
+
+// poolRetry is how long a pool waits before starting processes again after
+// one failed to start.
+const poolRetry = 10 * time.Second
+
+// WarmPool keeps processes started with the same options ready so that a new
+// process can be handed out without waiting for nvim to start.  Pooled
+// processes aren't listed by the manager until they're claimed.
+type WarmPool struct {
+	// Number of idle processes to keep ready.
+	Size int
+
+	// Options the processes are started with.  Processes created with the
+	// same options, ignoring the directory, files, and size, are taken from
+	// the pool.
+	Options ProcessOptions
+
+	manager *ProcessManager
+	mu      sync.Mutex
+	idle    []*Process
+	refill  chan struct{}
+	stop    chan struct{}
+}
+
+// AddPool creates a pool of embedded processes and starts filling it in the
+// background.
+func (m *ProcessManager) AddPool(size int, opts ProcessOptions) *WarmPool {
+	opts.Headless = false
+	opts.Address = ""
+	opts.Dir = ""
+	opts.Files = nil
+	if opts.Swap == SwapPrompt {
+		opts.Swap = m.Swap
+	}
+
+	pool := &WarmPool{
+		Size:    size,
+		Options: opts,
+		manager: m,
+		refill:  make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	m.pools = append(m.pools, pool)
+	m.mu.Unlock()
+
+	go pool.fill()
+	pool.signal()
+	return pool
+}
+
+// poolOptions removes the options that are applied when a process is claimed.
+func poolOptions(opts ProcessOptions) ProcessOptions {
+	opts.Dir = ""
+	opts.Files = nil
+	opts.Workspace = ""
+	opts.Width = 0
+	opts.Height = 0
+	return opts
+}
+
+// matches returns true if a process with opts can be taken from the pool.
+func (w *WarmPool) matches(opts ProcessOptions) bool {
+	if opts.Headless || opts.Address != "" || w.manager.Headless {
+		return false
+	}
+	return reflect.DeepEqual(poolOptions(w.Options), poolOptions(opts))
+}
+
+func (w *WarmPool) signal() {
+	select {
+	case w.refill <- struct{}{}:
+	default:
+	}
+}
+
+// fill starts processes until the pool is full whenever it's signaled.
+func (w *WarmPool) fill() {
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-w.refill:
+		}
+
+		for {
+			w.mu.Lock()
+			full := len(w.idle) >= w.Size
+			w.mu.Unlock()
+
+			if full {
+				break
+			}
+
+			p, err := w.manager.startPooled(w)
+			if err != nil {
+				util.Print("Couldn't start pooled process:", err)
+				select {
+				case <-time.After(poolRetry):
+					w.signal()
+				case <-w.stop:
+					return
+				}
+				break
+			}
+
+			w.mu.Lock()
+			select {
+			case <-w.stop:
+				w.mu.Unlock()
+				p.Kill()
+				return
+			default:
+			}
+			w.idle = append(w.idle, p)
+			w.mu.Unlock()
+		}
+	}
+}
+
+// take removes a running process from the pool.
+func (w *WarmPool) take() *Process {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for len(w.idle) > 0 {
+		p := w.idle[0]
+		w.idle = w.idle[1:]
+		if p.IsRunning() {
+			return p
+		}
+	}
+	return nil
+}
+
+// discard removes a process that exited before it was claimed.
+func (w *WarmPool) discard(p *Process) {
+	w.mu.Lock()
+	for i, idle := range w.idle {
+		if idle == p {
+			w.idle = append(w.idle[:i], w.idle[i+1:]...)
+			break
+		}
+	}
+	w.mu.Unlock()
+
+	w.signal()
+}
+
+// Stop stops refilling the pool and kills its idle processes.
+func (w *WarmPool) Stop() {
+	w.mu.Lock()
+	select {
+	case <-w.stop:
+		w.mu.Unlock()
+		return
+	default:
+		close(w.stop)
+	}
+	idle := w.idle
+	w.idle = nil
+	w.mu.Unlock()
+
+	for _, p := range idle {
+		if err := p.Kill(); err != nil && err != ErrNotRunning {
+			util.Print("Couldn't kill", p.name(), err)
+		}
+	}
+}
+
+// startPooled starts a process for a pool.  It reserves an ID but isn't added
+// to the manager's processes.
+func (m *ProcessManager) startPooled(pool *WarmPool) (*Process, error) {
+	m.mu.Lock()
+	m.nextID++
+	id := m.nextID
+	m.mu.Unlock()
+
+	proc := &Process{
+		ID:      id,
+		Name:    fmt.Sprintf("pool-%d", id),
+		Options: pool.Options,
+		manager: m,
+		pool:    pool,
+	}
+	proc.init()
+
+	if err := proc.start(); err != nil {
+		return nil, err
+	}
+
+	return proc, nil
+}
+
+// claim takes a process from a pool that matches opts.  It's renamed, moved to
+// the options' directory, opens the options' files, and runs the options'
+// commands.  nil is returned if no pool has a process ready.
+func (m *ProcessManager) claim(name string, opts ProcessOptions) (*Process, error) {
+	m.mu.Lock()
+	if name != "" && m.lookup(name) != nil {
+		m.mu.Unlock()
+		return nil, ErrProcessExists
+	}
+
+	var proc *Process
+	for _, pool := range m.pools {
+		if pool.matches(opts) {
+			if proc = pool.take(); proc != nil {
+				pool.signal()
+				break
+			}
+		}
+	}
+
+	if proc == nil {
+		m.mu.Unlock()
+		return nil, nil
+	}
+
+	if name == "" {
+		name = strconv.Itoa(proc.ID)
+	}
+
+	proc.mu.Lock()
+	proc.Name = name
+	proc.Options = opts
+	proc.pool = nil
+	proc.mu.Unlock()
+
+	m.procs[proc.ID] = proc
+	m.mu.Unlock()
+
+	util.Print("Claimed pooled process:", name)
+	m.emit(proc, StateRunning)
+
+	if err := proc.open(opts.Dir, opts.Files); err != nil {
+		util.Print("Couldn't open files in", name, err)
+	}
+
+	if err := proc.Resize(opts.size()); err != nil {
+		util.Print("Couldn't resize", name, err)
+	}
+
+	proc.runCommands()
+
+	proc.runHooks(HookStarted, nil)
+	proc.setIdle(true)
+	proc.startIdleTimer()
+	return proc, nil
+}
+
+// pooled returns the pool the process is waiting in, or nil if it's claimed.
+func (p *Process) pooled() *WarmPool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.pool
+}
+
+// open changes the process's directory and opens files in it.
+func (p *Process) open(dir string, files []string) error {
+	return p.do(func(n *nvim.Nvim) error {
+		if dir != "" {
+			if err := n.Command("cd " + fnameescape(dir)); err != nil {
+				return err
+			}
+		}
+
+		if len(files) > 0 {
+			escaped := make([]string, len(files))
+			for i, f := range files {
+				escaped[i] = fnameescape(f)
+			}
+			return n.Command("args " + strings.Join(escaped, " "))
+		}
+
+		return nil
+	})
+}
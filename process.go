@@ -1,15 +1,19 @@
 package nmux
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"io"
 	"log"
 	"os"
+	"os/exec"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/neovim/go-client/nvim"
 	"github.com/tweekmonster/nmux/screen"
+	"github.com/tweekmonster/nmux/util"
 )
 
 const (
@@ -20,39 +24,74 @@ const (
 
 var ErrFirstArgString = errors.New("first item must be a string")
 var ErrEmpty = errors.New("empty")
+var ErrNotRunning = errors.New("process is not running")
 
-// TODO: Multiple nvim process management.
-var procs struct {
-	mu    sync.Mutex
-	id    int
-	procs []*Process
-}
+type Process struct {
+	*screen.Screen
+	ID      int
+	Name    string
+	Options ProcessOptions
+	Deadman <-chan int
 
-func addProcess(proc *Process) {
-	procs.mu.Lock()
-	defer procs.mu.Unlock()
-	procs.procs = append(procs.procs, proc)
-}
+	mu        sync.Mutex
+	state     ProcessState
+	nvim      *nvim.Nvim
+	manager   *ProcessManager
+	extraArgs []string
+	deadman   chan int
+	stop      chan struct{}
+	killed    bool
+	lastExit  *ExitInfo
+	queue     chan *request
+	cmd       *exec.Cmd
+	pid       int
+	usage     usageSample
+	health    Health
+	idleTimer *time.Timer
+	idleSince time.Time
+	pool      *WarmPool
 
-func removeProcess(proc *Process) {
-	procs.mu.Lock()
-	defer procs.mu.Unlock()
+	// Whether nvim was left running on purpose.
+	disconnected bool
 
-	curProcs := procs.procs
-	procs.procs = procs.procs[0:0]
+	// Most important activity since a client was attached, and the last time
+	// the screen changed.
+	activity   screen.Activity
+	lastOutput time.Time
 
-	for _, p := range curProcs {
-		if p != proc {
-			procs.procs = append(procs.procs, p)
-		}
-	}
+	// Files open in nvim by buffer number.
+	buffers map[int]string
+	info    ProcessInfo
+
+	// Dialogs waiting for the client's reply by ID.
+	dialogs    map[int]chan string
+	nextDialog int
+
+	// Whether the screen is attached to nvim as a UI.  It's detached while
+	// there are no clients.
+	uiAttached bool
+
+	// The extensions the UI was attached with, and the ones the client can
+	// draw.
+	uiExt     map[string]bool
+	clientExt map[string]bool
+
+	// Attached clients in the order they attached.  The screen is sent to the
+	// last one.  attachMu is held while clients change so that the screen's
+	// sink matches the list.
+	attachMu sync.Mutex
+	clients  []client
+
+	// Size to apply when nvim isn't blocked.
+	pendingWidth  int
+	pendingHeight int
+	resizeTimer   *time.Timer
 }
 
-type Process struct {
-	*screen.Screen
-	ID      int
-	Deadman <-chan int
-	nvim    *nvim.Nvim
+// client is a writer attached to the process's screen.
+type client struct {
+	w   io.Writer
+	ext map[string]bool
 }
 
 type msg struct {
@@ -61,72 +100,478 @@ type msg struct {
 	args []interface{}
 }
 
-func NewProcess(cwd string, width, height int) (*Process, error) {
-	n, err := nvim.NewEmbedded(&nvim.EmbedOptions{
-		// Path: "/home/tallen/tmp/nvim/bin/nvim",
-		Env: os.Environ(),
-		Dir: cwd,
-		Logf: func(msg string, args ...interface{}) {
-			log.Println("Embedded Log:", msg, args)
-		},
+// notifications are sent to the process with rpcnotify() by autocmds.
+var notifications = []string{
+	"nmux_yank",
+	"nmux_buf_enter",
+	"nmux_buf_delete",
+	"nmux_cwd",
+	"nmux_buffer",
+}
+
+// autocmds send notifications from nvim to the process.  They're defined in
+// the "nmux" augroup after the UI is attached.
+var autocmds = []string{
+	`TextYankPost * if v:event.regname ==# '' | call rpcnotify(0, 'nmux_yank', v:event.regcontents, v:event.regtype) | endif`,
+	`BufEnter,BufFilePost * if empty(&buftype) && !empty(expand('<afile>')) | call rpcnotify(0, 'nmux_buf_enter', str2nr(expand('<abuf>')), expand('<afile>:p')) | endif`,
+	`BufDelete * call rpcnotify(0, 'nmux_buf_delete', str2nr(expand('<abuf>')))`,
+	`DirChanged * call rpcnotify(0, 'nmux_cwd', getcwd())`,
+	`BufEnter,BufFilePost,BufWritePost * let b:nmux_modified = &modified | call rpcnotify(0, 'nmux_buffer', expand('%:p'), &modified)`,
+	`TextChanged,TextChangedI * if &modified != get(b:, 'nmux_modified', 0) | let b:nmux_modified = &modified | call rpcnotify(0, 'nmux_buffer', expand('%:p'), &modified) | endif`,
+}
+
+// setupNotifications subscribes to the notifications sent by autocmds.
+func (p *Process) setupNotifications(n *nvim.Nvim) error {
+	for _, name := range notifications {
+		if err := n.Subscribe(name); err != nil {
+			return err
+		}
+	}
+
+	cmds := append([]string{"augroup nmux", "autocmd!"}, autocmds...)
+	for i := 2; i < len(cmds); i++ {
+		cmds[i] = "autocmd " + cmds[i]
+	}
+	cmds = append(cmds, "augroup END")
+
+	for _, cmd := range cmds {
+		if err := n.Command(cmd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// init creates the screen and the channels used by clients and the
+// supervisor.  It must be called before the process is visible to other
+// goroutines.
+func (p *Process) init() {
+	deadman := make(chan int)
+	p.Screen = screen.NewScreen(p.Options.size())
+	p.Screen.SetActivityHandler(p.activityHandler)
+	p.lastOutput = time.Now()
+	p.Deadman = deadman
+	p.deadman = deadman
+	p.stop = make(chan struct{})
+	p.queue = make(chan *request, 64)
+}
+
+// start runs the supervisor and waits for nvim to start.
+func (p *Process) start() error {
+	go p.processQueue()
+
+	started := make(chan error, 1)
+	go p.supervise(started)
+	return <-started
+}
+
+// spawn starts nvim with the --embed flag.  stderr receives the process's
+// stderr.  If the process is remote or a headless process is still running,
+// it's connected to instead and the returned command is nil.
+func (p *Process) spawn(stderr io.Writer) (*exec.Cmd, *nvim.Nvim, error) {
+	opts := p.options()
+	if opts.Address != "" {
+		n, err := p.dial()
+		if err == nil {
+			p.registerHandlers(n)
+			return nil, n, nil
+		} else if !opts.Headless {
+			return nil, nil, err
+		}
+	}
+
+	if opts.Headless {
+		return p.spawnHeadless()
+	}
+
+	path := opts.Path
+	if path == "" {
+		path = "nvim"
+	}
+
+	cmd := exec.Command(path, p.args("--embed")...)
+	cmd.Env = opts.environ()
+	cmd.Dir = opts.Dir
+	cmd.Stderr = stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := p.limit(cmd.Process.Pid); err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return nil, nil, err
+	}
+
+	n, err := nvim.New(stdout, stdin, stdin, func(msg string, args ...interface{}) {
+		log.Println("Embedded Log:", msg, args)
 	})
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return nil, nil, err
+	}
+
+	p.registerHandlers(n)
+	return cmd, n, nil
+}
+
+// args returns the arguments for nvim following flags.
+func (p *Process) args(flags ...string) []string {
+	opts := p.options()
+	args := append(flags, p.swapArgs()...)
+	args = append(args, opts.Args...)
+	args = append(args, p.extraArgs...)
+	if len(opts.Files) > 0 {
+		args = append(args, "--")
+		args = append(args, opts.Files...)
+	}
+	return args
+}
+
+func (p *Process) registerHandlers(n *nvim.Nvim) {
+	n.RegisterHandler("redraw", p.Screen.RedrawHandler)
+	n.RegisterHandler("nmux_yank", p.yankHandler)
+	n.RegisterHandler("nmux_buf_enter", p.bufEnterHandler)
+	n.RegisterHandler("nmux_buf_delete", p.bufDeleteHandler)
+	n.RegisterHandler("nmux_swap", p.swapHandler)
+	n.RegisterHandler("nmux_cwd", p.cwdHandler)
+	n.RegisterHandler("nmux_buffer", p.bufferHandler)
+}
+
+// attach attaches the screen to nvim and sets up notifications.  Serve must be
+// running for the requests to complete.
+func (p *Process) attach() error {
+	ctx, cancel := context.WithTimeout(context.Background(), attachTimeout)
+	defer cancel()
+
+	err := p.request(ctx, func(n *nvim.Nvim) error {
+		if err := p.attachUI(n); err != nil {
+			return err
+		}
+
+		// A headless process that's reconnected to wasn't started by this
+		// server, so its pid is only known to nvim.
+		if p.options().Headless {
+			var pid int
+			if err := n.Call("getpid", &pid); err != nil {
+				return err
+			}
+			p.setPid(pid)
+		}
+
+		if err := p.setupNotifications(n); err != nil {
+			return err
+		}
+
+		// Buffers opened before the autocmds were defined, e.g. by arguments or
+		// a session, aren't reported.
+		if err := p.syncBuffers(n); err != nil {
+			util.Print("Couldn't get buffers for", p.name(), err)
+		}
+
+		if err := p.syncInfo(n); err != nil {
+			util.Print("Couldn't get info for", p.name(), err)
+		}
 
+		if err := p.setChannel(n); err != nil {
+			util.Print("Couldn't set channel for", p.name(), err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	scr := screen.NewScreen(width, height)
-	n.RegisterHandler("redraw", scr.RedrawHandler)
+	p.manager.restoreRegister(p)
+	return nil
+}
 
-	procs.id++
-	deadman := make(chan int)
-	proc := &Process{
-		ID:      procs.id,
-		nvim:    n,
-		Deadman: deadman,
-		Screen:  scr,
+// name returns the process's name.  A pooled process is renamed when it's
+// claimed, so code that runs in the process's own goroutines uses name and
+// options instead of reading the fields.
+func (p *Process) name() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.Name
+}
+
+// options returns the process's options.  See name.
+func (p *Process) options() ProcessOptions {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.Options
+}
+
+// State returns the process's current lifecycle state.
+func (p *Process) State() ProcessState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.state
+}
+
+func (p *Process) setState(state ProcessState) {
+	p.mu.Lock()
+	if p.state == state || p.state == StateExited {
+		p.mu.Unlock()
+		return
+	}
+	p.state = state
+	pooled := p.pool != nil
+	p.mu.Unlock()
+
+	// Pooled processes are announced when they're claimed.
+	if !pooled {
+		p.manager.emit(p, state)
+	}
+}
+
+// client returns the nvim client if the process is still running.
+func (p *Process) client() (*nvim.Nvim, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.nvim == nil {
+		return nil, ErrNotRunning
+	}
+	return p.nvim, nil
+}
+
+// setKilled prevents the process from being restarted.
+func (p *Process) setKilled() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.killed {
+		p.killed = true
+		close(p.stop)
+	}
+}
+
+// Kill closes the connection to nvim, causing the embedded process to exit.
+// The process won't be restarted.  Remote processes are disconnected and keep
+// running.
+func (p *Process) Kill() error {
+	if p.IsRemote() {
+		return p.Disconnect()
+	}
+
+	if p.State() == StateExited {
+		return ErrNotRunning
 	}
 
-	addProcess(proc)
+	p.setKilled()
+	n, _ := p.client()
+	if n == nil {
+		return nil
+	}
+
+	if p.options().Headless {
+		// Closing the connection won't stop a headless process.  The error is
+		// ignored since nvim exits before responding.
+		p.do(func(n *nvim.Nvim) error {
+			return n.Command("qall!")
+		})
+	}
+
+	return n.Close()
+}
 
-	go func() {
-		if err := proc.nvim.Serve(); err != nil {
-			fmt.Println("RPC Err:", err)
+// terminate forcefully stops nvim without preventing a restart.  Remote
+// processes are disconnected instead.
+func (p *Process) terminate() error {
+	headless := p.options().Headless
+	p.mu.Lock()
+	cmd, n, pid := p.cmd, p.nvim, p.pid
+	p.mu.Unlock()
+
+	if cmd != nil && cmd.Process != nil {
+		return cmd.Process.Kill()
+	}
+
+	// A headless nvim that was reconnected to isn't a child of the server,
+	// so it's killed with the pid that nvim reported.
+	if headless && pid != 0 {
+		proc, err := os.FindProcess(pid)
+		if err != nil {
+			return err
 		}
-		proc.nvim = nil
-		removeProcess(proc)
-		close(deadman)
-		fmt.Println("Dead")
-	}()
+		return proc.Kill()
+	}
+
+	if n != nil {
+		return n.Close()
+	}
+	return ErrNotRunning
+}
+
+// Disconnect detaches from nvim without stopping it.  The process won't be
+// restarted.
+func (p *Process) Disconnect() error {
+	if p.State() == StateExited {
+		return ErrNotRunning
+	}
 
-	if err := n.AttachUI(proc.Size.X, proc.Size.Y, map[string]interface{}{
-		"rgb":                true,
-		"popupmenu_external": false,
-	}); err != nil {
-		return nil, err
+	p.setKilled()
+	p.mu.Lock()
+	p.disconnected = true
+	p.mu.Unlock()
+
+	n, _ := p.client()
+	if n == nil {
+		return nil
+	}
+
+	err := p.do(func(n *nvim.Nvim) error {
+		if err := n.Command("silent! autocmd! nmux"); err != nil {
+			return err
+		}
+		return p.detachUI(n)
+	})
+	if err != nil {
+		util.Print("Couldn't detach UI from", p.name(), err)
 	}
 
-	return proc, nil
+	return n.Close()
 }
 
-func (p *Process) Attach(w io.Writer) error {
+// Attach sends the screen to w.  ext are the ClientExtensions the client can
+// draw.  A UI that was suspended while the process had no client is
+// reattached.
+func (p *Process) Attach(w io.Writer, ext []string) error {
+	clientExt := make(map[string]bool, len(ext))
+	for _, name := range ext {
+		clientExt[name] = true
+	}
+
+	p.attachMu.Lock()
+	p.clients = append(p.clients, client{w: w, ext: clientExt})
+	first := len(p.clients) == 1
+
+	p.mu.Lock()
+	p.clientExt = clientExt
+	p.mu.Unlock()
+
+	p.stopIdleTimer()
+	p.setIdle(false)
+	p.clearActivity()
+	p.manager.touchWorkspace(p.options().Workspace)
 	p.Screen.SetSink(w)
+	p.attachMu.Unlock()
+
+	if err := p.resumeUI(); err != nil {
+		return err
+	}
+
+	// The hook only runs when the process gets its first client.
+	if first {
+		p.runHooks(HookAttached, nil)
+	}
 	return nil
 }
 
-func (p *Process) Detach() error {
+// Detach stops sending the screen to a client.  If other clients are still
+// attached, the screen is sent to the one that attached last.  Otherwise, the
+// UI is suspended.
+func (p *Process) Detach(w io.Writer) error {
+	p.attachMu.Lock()
+	for i, c := range p.clients {
+		if c.w == w {
+			p.clients = append(p.clients[:i], p.clients[i+1:]...)
+			break
+		}
+	}
+
+	if len(p.clients) > 0 {
+		next := p.clients[len(p.clients)-1]
+		viewing := p.Screen.Sink() == w
+		if viewing {
+			p.mu.Lock()
+			p.clientExt = next.ext
+			p.mu.Unlock()
+
+			p.cancelDialogs()
+			p.Screen.SetSink(next.w)
+		}
+		p.attachMu.Unlock()
+
+		if viewing {
+			return p.resumeUI()
+		}
+		return nil
+	}
+
 	p.Screen.SetSink(nil)
+	p.attachMu.Unlock()
+
+	p.cancelDialogs()
+	p.runHooks(HookDetached, nil)
+	p.idle()
 	return nil
 }
 
 func (p *Process) IsRunning() bool {
-	return p.nvim != nil
+	return p.State() == StateRunning
 }
 
+// Input sends keys to nvim.  They aren't queued so that they can answer a
+// prompt that's blocking the queued requests.
 func (p *Process) Input(keys string) (int, error) {
-	return p.nvim.Input(keys)
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	var written int
+	err := p.fastRequest(ctx, func(n *nvim.Nvim) (err error) {
+		written, err = n.Input(keys)
+		return
+	})
+	return written, err
+}
+
+// ModifiedBuffers returns the names of buffers with unsaved changes.
+func (p *Process) ModifiedBuffers() ([]string, error) {
+	var names []string
+	err := p.do(func(n *nvim.Nvim) error {
+		return n.Eval(`map(getbufinfo({'bufmodified': 1}), 'empty(v:val.name) ? "[No Name]" : v:val.name')`, &names)
+	})
+	return names, err
 }
 
-func (p *Process) Resize(w, h int) error {
-	return p.nvim.TryResizeUI(w, h)
+// Preserve writes all buffers to their swap files so changes can be recovered.
+func (p *Process) Preserve() error {
+	return p.do(func(n *nvim.Nvim) error {
+		return n.Command("silent! preserve")
+	})
+}
+
+// SaveSession writes the process's session and shada files.
+func (p *Process) SaveSession(session, shada string) error {
+	return p.do(func(n *nvim.Nvim) error {
+		if err := n.Command("mksession! " + fnameescape(session)); err != nil {
+			return err
+		}
+		return n.Command("wshada! " + fnameescape(shada))
+	})
+}
+
+// fnameescape escapes a file name for use in an Ex command.
+func fnameescape(name string) string {
+	var out []rune
+	for _, c := range name {
+		if strings.ContainsRune(" \t\n*?[{`$\\%#'\"|!<", c) {
+			out = append(out, '\\')
+		}
+		out = append(out, c)
+	}
+	return string(out)
 }
@@ -0,0 +1,129 @@
+package nmux
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+
+	"github.com/neovim/go-client/nvim"
+	"github.com/tweekmonster/nmux/util"
+)
+
+// Profile is a named set of options for starting processes.  In a profiles
+// file, the options are fields of the profile:
+//
+//	{"notes": {"dir": "/home/me/notes", "args": ["-u", "NONE"], "pool": 1}}
+type Profile struct {
+	ProcessOptions
+
+	// Number of processes to keep in a warm pool for the profile.
+	Pool int `json:"pool,omitempty"`
+}
+
+// LoadProfiles reads profiles from a JSON file mapping profile names to
+// profiles.
+func LoadProfiles(filename string) (map[string]Profile, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles map[string]Profile
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// SetProfiles replaces the manager's profiles and creates the warm pools they
+// ask for.
+func (m *ProcessManager) SetProfiles(profiles map[string]Profile) {
+	annotated := make(map[string]Profile, len(profiles))
+	for name, profile := range profiles {
+		profile.Profile = name
+		annotated[name] = profile
+	}
+
+	m.mu.Lock()
+	m.profiles = annotated
+	m.mu.Unlock()
+
+	// The pools are created from the named profiles so their options match
+	// the options of processes created from the profiles.
+	for _, profile := range annotated {
+		if profile.Pool > 0 {
+			m.AddPool(profile.Pool, profile.Options())
+		}
+	}
+}
+
+// Profile returns the profile with the specified name.
+func (m *ProcessManager) Profile(name string) (Profile, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	profile, ok := m.profiles[name]
+	return profile, ok
+}
+
+// Profiles returns the names of the manager's profiles.
+func (m *ProcessManager) Profiles() []string {
+	m.mu.Lock()
+	names := make([]string, 0, len(m.profiles))
+	for name := range m.profiles {
+		names = append(names, name)
+	}
+	m.mu.Unlock()
+
+	sort.Strings(names)
+	return names
+}
+
+// Options returns the options for a process started with the profile.
+func (p Profile) Options() ProcessOptions {
+	opts := p.ProcessOptions
+	def := DefaultProcessOptions()
+	if opts.Width <= 0 {
+		opts.Width = def.Width
+	}
+	if opts.Height <= 0 {
+		opts.Height = def.Height
+	}
+	return opts
+}
+
+// CreateFromProfile starts a process with a profile's options.  If name is
+// empty, the profile name is used.
+func (m *ProcessManager) CreateFromProfile(name, profile string) (*Process, error) {
+	prof, ok := m.Profile(profile)
+	if !ok {
+		return nil, ErrNoProfile
+	}
+
+	if name == "" {
+		name = profile
+	}
+
+	return m.Create(name, prof.Options())
+}
+
+// runCommands runs the options' initial Ex commands.  Failures are logged
+// since the process is usable without them.
+func (p *Process) runCommands() {
+	commands := p.options().Commands
+	if len(commands) == 0 {
+		return
+	}
+
+	err := p.do(func(n *nvim.Nvim) error {
+		for _, cmd := range commands {
+			if err := n.Command(cmd); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		util.Print("Initial commands failed for", p.name(), err)
+	}
+}
@@ -0,0 +1,77 @@
+package nmux
+
+import (
+	"github.com/neovim/go-client/nvim"
+	"github.com/tweekmonster/nmux/util"
+)
+
+// register is the contents of the unnamed register shared between processes.
+type register struct {
+	contents []string
+	regtype  string
+}
+
+// yankHandler receives the unnamed register after text is yanked in the
+// process and shares it with the other processes.
+func (p *Process) yankHandler(contents []string, regtype string) {
+	p.manager.shareRegister(p, register{contents: contents, regtype: regtype})
+}
+
+// setRegister sets the unnamed register.  This doesn't trigger TextYankPost, so
+// the register isn't shared again.
+func (p *Process) setRegister(reg register) error {
+	return p.do(func(n *nvim.Nvim) error {
+		return n.Call("setreg", nil, `"`, reg.contents, reg.regtype)
+	})
+}
+
+// shareRegister stores the register and wakes the register worker to send it
+// to all running processes except the one it came from.
+func (m *ProcessManager) shareRegister(from *Process, reg register) {
+	m.mu.Lock()
+	m.register = &reg
+	m.registerFrom = from
+	m.mu.Unlock()
+
+	select {
+	case m.registerWake <- struct{}{}:
+	default:
+		// The worker hasn't woken up for an earlier register yet, and it will
+		// send this one instead.
+	}
+}
+
+// shareRegisters sets the latest register in the processes whenever it
+// changes, for the lifetime of the manager.  Registers that are replaced
+// before the worker gets to them are skipped.  A blocked process delays the
+// others by at most one request timeout.
+func (m *ProcessManager) shareRegisters() {
+	for range m.registerWake {
+		m.mu.Lock()
+		reg, from := *m.register, m.registerFrom
+		m.mu.Unlock()
+
+		for _, p := range m.List() {
+			if p == from || !p.IsRunning() {
+				continue
+			}
+
+			if err := p.setRegister(reg); err != nil {
+				util.Print("Couldn't share register with", p.name(), err)
+			}
+		}
+	}
+}
+
+// restoreRegister sets the last shared register in a new process.
+func (m *ProcessManager) restoreRegister(p *Process) {
+	m.mu.Lock()
+	reg := m.register
+	m.mu.Unlock()
+
+	if reg != nil {
+		if err := p.setRegister(*reg); err != nil {
+			util.Print("Couldn't share register with", p.name(), err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package nmux
+
+import (
+	"log"
+	"net"
+	"strings"
+
+	"github.com/neovim/go-client/nvim"
+)
+
+// dialNetwork returns the network for an nvim --listen address.  Addresses
+// with a port are TCP addresses.  Everything else is a unix socket.
+func dialNetwork(address string) string {
+	if strings.HasPrefix(address, "/") || strings.HasPrefix(address, ".") {
+		return "unix"
+	}
+
+	if _, _, err := net.SplitHostPort(address); err == nil {
+		return "tcp"
+	}
+	return "unix"
+}
+
+// dial connects to an nvim process that was started elsewhere, e.g. with
+// "nvim --listen".
+func (p *Process) dial() (*nvim.Nvim, error) {
+	address := p.options().Address
+	conn, err := net.Dial(dialNetwork(address), address)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := nvim.New(conn, conn, conn, func(msg string, args ...interface{}) {
+		log.Println("Remote Log:", msg, args)
+	})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// IsRemote returns true if the process was started outside of nmux.  Remote
+// processes are disconnected instead of killed.
+func (p *Process) IsRemote() bool {
+	opts := p.options()
+	return opts.Address != "" && !opts.Headless
+}
@@ -0,0 +1,260 @@
+package nmux
+
+import (
+	"fmt"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/tweekmonster/nmux/util"
+)
+
+const (
+	stderrTailSize = 4096
+	minRestartWait = time.Second
+	maxRestartWait = time.Minute
+)
+
+// RestartPolicy determines whether a process is restarted after it exits.
+type RestartPolicy string
+
+// Restart policies.
+const (
+	RestartNever     RestartPolicy = ""
+	RestartOnFailure RestartPolicy = "on-failure"
+	RestartAlways    RestartPolicy = "always"
+)
+
+// ParseRestartPolicy returns the RestartPolicy for a name.  "never" and an
+// empty string are RestartNever.
+func ParseRestartPolicy(name string) (RestartPolicy, error) {
+	switch name {
+	case "", "never":
+		return RestartNever, nil
+	case string(RestartOnFailure), string(RestartAlways):
+		return RestartPolicy(name), nil
+	}
+	return RestartNever, fmt.Errorf("unknown restart policy: %q", name)
+}
+
+// ExitInfo describes how a process exited.
+type ExitInfo struct {
+	Time   time.Time `json:"time"`
+	Code   int       `json:"code"`
+	Error  string    `json:"error,omitempty"`
+	Stderr string    `json:"stderr,omitempty"`
+}
+
+// Failed returns true if the process didn't exit cleanly.
+func (e ExitInfo) Failed() bool {
+	return e.Code != 0 || e.Error != ""
+}
+
+// tailBuffer keeps the last bytes written to it.
+type tailBuffer struct {
+	mu   sync.Mutex
+	size int
+	buf  []byte
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.buf = append(t.buf, p...)
+	if len(t.buf) > t.size {
+		t.buf = append([]byte{}, t.buf[len(t.buf)-t.size:]...)
+	}
+	return len(p), nil
+}
+
+func (t *tailBuffer) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return string(t.buf)
+}
+
+// LastExit returns how the process last exited, or nil if it hasn't.
+func (p *Process) LastExit() *ExitInfo {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lastExit
+}
+
+func exitInfo(err error, stderr *tailBuffer) *ExitInfo {
+	info := &ExitInfo{
+		Time:   time.Now(),
+		Stderr: stderr.String(),
+	}
+
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+				info.Code = status.ExitStatus()
+			}
+		}
+		info.Error = err.Error()
+	}
+
+	return info
+}
+
+// shouldRestart returns true if the restart policy allows the process to be
+// restarted after exiting.
+func (p *Process) shouldRestart(exit *ExitInfo) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.killed {
+		return false
+	}
+
+	switch p.Options.Restart {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return exit.Failed()
+	}
+	return false
+}
+
+// supervise runs nvim and restarts it according to the restart policy.  The
+// result of the first start is sent to started.  Clients stay attached to the
+// process's screen across restarts and receive a full redraw once the new nvim
+// is attached.
+func (p *Process) supervise(started chan<- error) {
+	wait := minRestartWait
+
+loop:
+	for {
+		stderr := &tailBuffer{size: stderrTailSize}
+		cmd, n, err := p.spawn(stderr)
+		var exit *ExitInfo
+
+		if err == nil {
+			p.mu.Lock()
+			p.nvim = n
+			p.cmd = cmd
+			p.health = Health{}
+			p.mu.Unlock()
+
+			if cmd != nil {
+				p.setPid(cmd.Process.Pid)
+			}
+
+			serveErr := make(chan error, 1)
+			go func() {
+				serveErr <- n.Serve()
+			}()
+
+			err = p.attach()
+			if started != nil {
+				started <- err
+				if err != nil {
+					// Don't restart processes that couldn't start.
+					p.setKilled()
+				}
+				started = nil
+			}
+
+			if err != nil {
+				n.Close()
+			} else {
+				// The session that was restored is stale after a restart.
+				p.extraArgs = nil
+
+				if p.State() == StateRestarting {
+					p.Screen.SetSink(p.Screen.Sink())
+				}
+				p.setState(StateRunning)
+				// A pooled process runs its commands in its directory once
+				// it's claimed.
+				if p.pooled() == nil {
+					p.runCommands()
+				}
+				p.runHooks(HookStarted, nil)
+				if p.Screen.Sink() == nil {
+					p.idle()
+				}
+
+				// Apply a resize that was requested while restarting.
+				if err := p.applyResize(); err != nil {
+					util.Print("Couldn't resize", p.name(), err)
+				}
+			}
+
+			runStart := time.Now()
+			if err := <-serveErr; err != nil {
+				util.Print("RPC Err:", err)
+			}
+
+			p.mu.Lock()
+			p.nvim = nil
+			p.cmd = nil
+			p.uiAttached = false
+			p.buffers = nil
+			disconnected := p.disconnected
+			p.mu.Unlock()
+			p.setPid(0)
+
+			// A headless nvim that was disconnected keeps running, so it isn't
+			// waited for.
+			var waitErr error
+			if cmd != nil && disconnected {
+				cmd.Process.Release()
+			} else if cmd != nil {
+				waitErr = cmd.Wait()
+			}
+			exit = exitInfo(waitErr, stderr)
+
+			if dir, _ := p.cgroupDir(); dir != "" && !disconnected {
+				removeCgroup(dir)
+			}
+
+			// Reset the backoff if the process was running for a while.
+			if time.Since(runStart) > maxRestartWait {
+				wait = minRestartWait
+			}
+		} else {
+			if started != nil {
+				started <- err
+				p.setKilled()
+				started = nil
+			}
+			exit = exitInfo(err, stderr)
+		}
+
+		p.mu.Lock()
+		p.lastExit = exit
+		p.mu.Unlock()
+		p.runHooks(HookExited, exit)
+
+		if exit.Failed() {
+			util.Print("Process failed:", p.name(), exit.Error, exit.Stderr)
+		}
+
+		if !p.shouldRestart(exit) {
+			break
+		}
+
+		p.setState(StateRestarting)
+		util.Print("Restarting", p.name(), "in", wait.String())
+
+		select {
+		case <-time.After(wait):
+		case <-p.stop:
+			break loop
+		}
+
+		if wait *= 2; wait > maxRestartWait {
+			wait = maxRestartWait
+		}
+	}
+
+	p.stopIdleTimer()
+	p.setState(StateExited)
+	p.manager.remove(p)
+	close(p.deadman)
+	util.Print("Process exited:", p.name())
+}
@@ -0,0 +1,217 @@
+package nmux
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neovim/go-client/nvim"
+	"github.com/tweekmonster/nmux/util"
+)
+
+const (
+	rpcTimeout    = 2 * time.Second
+	attachTimeout = 10 * time.Second
+	resizeRetry   = 250 * time.Millisecond
+)
+
+var ErrTimeout = errors.New("nvim request timed out")
+var ErrBlocked = errors.New("nvim is blocked by an earlier request")
+
+// request is a call waiting in a process's queue.
+type request struct {
+	ctx  context.Context
+	fn   func(*nvim.Nvim) error
+	done chan error
+}
+
+func contextError(ctx context.Context) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrTimeout
+	}
+	return ctx.Err()
+}
+
+// processQueue runs queued requests in order until the process exits.  A
+// request that doesn't finish before its context is done is abandoned so that
+// a blocked nvim doesn't hold up the requests after it.  Until the abandoned
+// call returns, requests fail with ErrBlocked instead of starting calls that
+// would wait behind it.
+func (p *Process) processQueue() {
+	var pending <-chan error
+	for {
+		select {
+		case req := <-p.queue:
+			var err error
+			pending, err = p.runRequest(req, pending)
+			req.done <- err
+		case <-p.deadman:
+			return
+		}
+	}
+}
+
+// runRequest calls the request's function unless pending, the result of an
+// abandoned call, hasn't been received.  The returned channel is the result
+// of the call that's still pending, if any.
+func (p *Process) runRequest(req *request, pending <-chan error) (<-chan error, error) {
+	if pending != nil {
+		select {
+		case <-pending:
+		default:
+			return pending, ErrBlocked
+		}
+	}
+
+	if req.ctx.Err() != nil {
+		return nil, contextError(req.ctx)
+	}
+
+	n, err := p.client()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- req.fn(n)
+	}()
+
+	select {
+	case err := <-result:
+		return nil, err
+	case <-req.ctx.Done():
+		return result, contextError(req.ctx)
+	}
+}
+
+// request queues fn to be called with the nvim client and waits for it to
+// finish, for ctx to be done, or for the process to exit.
+func (p *Process) request(ctx context.Context, fn func(*nvim.Nvim) error) error {
+	req := &request{
+		ctx:  ctx,
+		fn:   fn,
+		done: make(chan error, 1),
+	}
+
+	select {
+	case p.queue <- req:
+	case <-ctx.Done():
+		return contextError(ctx)
+	case <-p.deadman:
+		return ErrNotRunning
+	}
+
+	// The queue isn't processed after the process exits, so the request may
+	// never be run.
+	select {
+	case err := <-req.done:
+		return err
+	case <-ctx.Done():
+		return contextError(ctx)
+	case <-p.deadman:
+		return ErrNotRunning
+	}
+}
+
+// do is request with the default timeout.
+func (p *Process) do(fn func(*nvim.Nvim) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	return p.request(ctx, fn)
+}
+
+// fastRequest calls fn with the nvim client without queuing it.  It's only
+// for nvim's fast API functions, which respond even when nvim is blocked, so
+// they must not wait behind requests that are stuck.
+func (p *Process) fastRequest(ctx context.Context, fn func(*nvim.Nvim) error) error {
+	n, err := p.client()
+	if err != nil {
+		return err
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- fn(n)
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return contextError(ctx)
+	}
+}
+
+// Blocking returns true if nvim is waiting for input that blocks requests,
+// e.g. a prompt.
+func (p *Process) Blocking(ctx context.Context) (bool, error) {
+	var mode map[string]interface{}
+	err := p.fastRequest(ctx, func(n *nvim.Nvim) error {
+		return n.Request("nvim_get_mode", &mode)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	blocking, _ := mode["blocking"].(bool)
+	return blocking, nil
+}
+
+// Resize resizes the UI.  If nvim is blocked, the resize is deferred until
+// it's able to respond.  Only the most recent size is applied.
+func (p *Process) Resize(w, h int) error {
+	p.mu.Lock()
+	p.pendingWidth, p.pendingHeight = w, h
+	p.mu.Unlock()
+
+	return p.applyResize()
+}
+
+func (p *Process) applyResize() error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	blocking, err := p.Blocking(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	w, h := p.pendingWidth, p.pendingHeight
+	if w == 0 || h == 0 || !p.uiAttached {
+		// A suspended UI is resized when it's resumed.
+		p.mu.Unlock()
+		return nil
+	}
+
+	if blocking {
+		if p.resizeTimer == nil {
+			util.Debug("Deferring resize for", p.Name)
+			p.resizeTimer = time.AfterFunc(resizeRetry, p.retryResize)
+		}
+		p.mu.Unlock()
+		return nil
+	}
+
+	p.pendingWidth, p.pendingHeight = 0, 0
+	p.mu.Unlock()
+
+	return p.request(ctx, func(n *nvim.Nvim) error {
+		return n.TryResizeUI(w, h)
+	})
+}
+
+func (p *Process) retryResize() {
+	p.mu.Lock()
+	p.resizeTimer = nil
+	p.mu.Unlock()
+
+	if !p.IsRunning() {
+		return
+	}
+
+	if err := p.applyResize(); err != nil {
+		util.Print("Couldn't resize", p.name(), err)
+	}
+}
@@ -0,0 +1,234 @@
+package screen
+
+// Cmdline is a command-line that nvim is waiting for input in when it's drawn
+// by the client.
+type Cmdline struct {
+	Content string
+
+	// Cursor position in Content, in bytes.
+	Pos int
+
+	// The command-line's type character, e.g. ":" or "/".  It's empty for
+	// prompts like input().
+	Firstc string
+	Prompt string
+	Indent int
+
+	// Nesting level.  A command-line can be opened from another one, e.g. with
+	// <C-R>=.
+	Level int
+
+	// Character shown at the cursor until the next input, e.g. after <C-V>.
+	// If SpecialShift is true, the content after the cursor is shifted.
+	SpecialChar  string
+	SpecialShift bool
+}
+
+// Wildmenu is the state of the wildmenu when it's drawn by the client.
+type Wildmenu struct {
+	Visible bool
+	Items   []string
+
+	// Index of the selected item, or -1 if none is selected.
+	Selected int
+}
+
+// chunksText joins the text of highlighted chunks.  The highlight of each
+// chunk is ignored.
+func chunksText(chunks *opArgs) string {
+	var text string
+	for chunks.Len() > 0 {
+		chunk := chunks.Array()
+		chunk.next() // Highlight
+		text += chunk.Text()
+	}
+	return text
+}
+
+// cmdlineLevel returns the command-line at a level, adding it if it's new.
+func (s *Screen) cmdlineLevel(level int) *Cmdline {
+	if level < 1 {
+		level = 1
+	}
+
+	for len(s.cmdlines) < level {
+		s.cmdlines = append(s.cmdlines, Cmdline{Level: len(s.cmdlines) + 1})
+	}
+	return &s.cmdlines[level-1]
+}
+
+func (s *Screen) cmdlineShow(args *opArgs) {
+	c := Cmdline{
+		Content: chunksText(args.Array()),
+		Pos:     args.Int(),
+		Firstc:  args.Text(),
+		Prompt:  args.Text(),
+		Indent:  args.Int(),
+		Level:   args.Int(),
+	}
+
+	*s.cmdlineLevel(c.Level) = c
+	s.writeCmdline(&c)
+}
+
+func (s *Screen) cmdlinePos(args *opArgs) {
+	pos := args.Int()
+	c := s.cmdlineLevel(args.Int())
+	c.Pos = pos
+	c.SpecialChar = ""
+	c.SpecialShift = false
+
+	s.payload.WriteOp(OpCmdlinePos)
+	s.payload.WriteEncodedInts(c.Level, c.Pos)
+}
+
+func (s *Screen) cmdlineSpecialChar(args *opArgs) {
+	char := args.Text()
+	shift, _ := args.next().(bool)
+	c := s.cmdlineLevel(args.Int())
+	c.SpecialChar = char
+	c.SpecialShift = shift
+
+	s.writeCmdlineSpecialChar(c)
+}
+
+func (s *Screen) cmdlineHide(args *opArgs) {
+	level := args.Int()
+	if level < 1 {
+		level = 1
+	}
+
+	if len(s.cmdlines) >= level {
+		s.cmdlines = s.cmdlines[:level-1]
+	}
+
+	s.payload.WriteOp(OpCmdlineHide)
+	s.payload.WriteEncodedInt(level)
+}
+
+func (s *Screen) cmdlineBlockShow(args *opArgs) {
+	lines := args.Array()
+	s.cmdlineBlock = make([]string, 0, lines.Len())
+	for lines.Len() > 0 {
+		s.cmdlineBlock = append(s.cmdlineBlock, chunksText(lines.Array()))
+	}
+	s.writeCmdlineBlock()
+}
+
+func (s *Screen) cmdlineBlockAppend(args *opArgs) {
+	line := chunksText(args.Array())
+	s.cmdlineBlock = append(s.cmdlineBlock, line)
+
+	s.payload.WriteOp(OpCmdlineBlockAppend)
+	s.payload.WriteStringRun(line)
+}
+
+func (s *Screen) cmdlineBlockHide() {
+	s.cmdlineBlock = nil
+	s.payload.WriteOp(OpCmdlineBlockHide)
+}
+
+func (s *Screen) wildmenuShow(args *opArgs) {
+	items := args.Array()
+	wm := Wildmenu{
+		Visible:  true,
+		Items:    make([]string, 0, items.Len()),
+		Selected: -1,
+	}
+	for items.Len() > 0 {
+		wm.Items = append(wm.Items, items.Text())
+	}
+
+	s.wildmenu = wm
+	s.writeWildmenu()
+}
+
+// CmdlineState returns copies of the open command-lines, innermost last.
+func (s *Screen) CmdlineState() []Cmdline {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Cmdline(nil), s.cmdlines...)
+}
+
+// WildmenuState returns a copy of the wildmenu's state.
+func (s *Screen) WildmenuState() Wildmenu {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wm := s.wildmenu
+	wm.Items = append([]string(nil), wm.Items...)
+	return wm
+}
+
+// CmdlineActive returns true if nvim is waiting for command-line input.
+func (s *Screen) CmdlineActive() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.cmdlines) > 0
+}
+
+func (s *Screen) writeCmdline(c *Cmdline) {
+	p := s.payload
+	p.WriteOp(OpCmdlineShow)
+	p.WriteEncodedInts(c.Level, c.Pos, c.Indent)
+	p.WriteStringRun(c.Firstc)
+	p.WriteStringRun(c.Prompt)
+	p.WriteStringRun(c.Content)
+}
+
+func (s *Screen) writeCmdlineSpecialChar(c *Cmdline) {
+	p := s.payload
+	p.WriteOp(OpCmdlineSpecialChar)
+	p.WriteEncodedInt(c.Level)
+	if c.SpecialShift {
+		p.WriteByte(1)
+	} else {
+		p.WriteByte(0)
+	}
+	p.WriteStringRun(c.SpecialChar)
+}
+
+func (s *Screen) writeCmdlineBlock() {
+	p := s.payload
+	p.WriteOp(OpCmdlineBlockShow)
+	p.WriteEncodedInt(len(s.cmdlineBlock))
+	for _, line := range s.cmdlineBlock {
+		p.WriteStringRun(line)
+	}
+}
+
+// writeWildmenu sends the wildmenu.  The selected index is offset by one so
+// that no selection is zero.
+func (s *Screen) writeWildmenu() {
+	p := s.payload
+	if !s.wildmenu.Visible {
+		p.WriteOp(OpWildmenuHide)
+		return
+	}
+
+	p.WriteOp(OpWildmenuShow)
+	p.WriteEncodedInts(s.wildmenu.Selected+1, len(s.wildmenu.Items))
+	for _, item := range s.wildmenu.Items {
+		p.WriteStringRun(item)
+	}
+}
+
+// writeCmdlineState sends the open command-lines and the wildmenu to a new
+// client.
+func (s *Screen) writeCmdlineState() {
+	if len(s.cmdlineBlock) > 0 {
+		s.writeCmdlineBlock()
+	}
+
+	for i := range s.cmdlines {
+		c := &s.cmdlines[i]
+		s.writeCmdline(c)
+		if c.SpecialChar != "" {
+			s.writeCmdlineSpecialChar(c)
+		}
+	}
+
+	if s.wildmenu.Visible {
+		s.writeWildmenu()
+	}
+}
@@ -25,6 +25,30 @@ const (
 	OpScroll
 	OpFlush
 	OpLog
+	OpShutdown
+	OpError
+	OpDialog
+	OpActivity
+	OpPopupmenuShow
+	OpPopupmenuSelect
+	OpPopupmenuHide
+	OpTabline
+	OpCmdlineShow
+	OpCmdlinePos
+	OpCmdlineSpecialChar
+	OpCmdlineHide
+	OpCmdlineBlockShow
+	OpCmdlineBlockAppend
+	OpCmdlineBlockHide
+	OpWildmenuShow
+	OpWildmenuSelect
+	OpWildmenuHide
+	OpMessageShow
+	OpMessageClear
+	OpMessageShowmode
+	OpMessageShowcmd
+	OpMessageRuler
+	OpMessageHistory
 	OpEnd
 )
 
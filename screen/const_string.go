@@ -4,9 +4,9 @@ package screen
 
 import "fmt"
 
-const _Op_name = "OpResizeOpClearOpKeyboardOpCursorOpPaletteOpStyleOpPutOpPutRepOpTitleOpIconOpBellOpScrollOpFlushOpLogOpEnd"
+const _Op_name = "OpResizeOpClearOpKeyboardOpCursorOpPaletteOpStyleOpPutOpPutRepOpTitleOpIconOpBellOpScrollOpFlushOpLogOpShutdownOpErrorOpDialogOpActivityOpPopupmenuShowOpPopupmenuSelectOpPopupmenuHideOpTablineOpCmdlineShowOpCmdlinePosOpCmdlineSpecialCharOpCmdlineHideOpCmdlineBlockShowOpCmdlineBlockAppendOpCmdlineBlockHideOpWildmenuShowOpWildmenuSelectOpWildmenuHideOpMessageShowOpMessageClearOpMessageShowmodeOpMessageShowcmdOpMessageRulerOpMessageHistoryOpEnd"
 
-var _Op_index = [...]uint8{0, 8, 15, 25, 33, 42, 49, 54, 62, 69, 75, 81, 89, 96, 101, 106}
+var _Op_index = [...]uint16{0, 8, 15, 25, 33, 42, 49, 54, 62, 69, 75, 81, 89, 96, 101, 111, 118, 126, 136, 151, 168, 183, 192, 205, 217, 237, 250, 268, 288, 306, 320, 336, 350, 363, 377, 394, 410, 424, 440, 445}
 
 func (i Op) String() string {
 	i -= 1
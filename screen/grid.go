@@ -0,0 +1,156 @@
+package screen
+
+// The ext_linegrid protocol.  Only the global grid is drawn since ext_multigrid
+// isn't enabled.  Highlights are defined once with hl_attr_define and cells
+// refer to them by ID.
+
+// globalGrid is the ID of the grid that's the whole screen.
+const globalGrid = 1
+
+// linegridOp handles an ext_linegrid redraw op.  It returns false if op isn't
+// part of the protocol.
+func (s *Screen) linegridOp(op string, args *opArgs) bool {
+	switch op {
+	case "default_colors_set":
+		s.defaultColorsSet(args)
+
+	case "hl_attr_define":
+		id := args.Int()
+		def := args.Map()
+		s.hlDefs[id] = def
+		s.hlAttrs[id] = s.cellAttrs(def)
+
+	case "grid_resize":
+		if args.Int() != globalGrid {
+			break
+		}
+		s.setSize(args.Int(), args.Int())
+		s.writeSize()
+
+	case "grid_clear":
+		if args.Int() != globalGrid {
+			break
+		}
+		s.clearScreen()
+		s.writeClear()
+
+	case "grid_cursor_goto":
+		if args.Int() != globalGrid {
+			break
+		}
+		y := args.Int()
+		x := args.Int()
+		s.setCursor(x, y)
+
+	case "grid_line":
+		if args.Int() != globalGrid {
+			break
+		}
+		s.gridLine(args)
+
+	case "grid_scroll":
+		if args.Int() != globalGrid {
+			break
+		}
+		s.gridScroll(args)
+
+	case "grid_destroy", "flush", "hl_group_set":
+		// Nothing to do.  The screen is flushed after each batch of updates.
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// defaultColorsSet sets the default colors and redefines the highlights that
+// use them.
+func (s *Screen) defaultColorsSet(args *opArgs) {
+	s.DefaultAttrs.Fg = Color(args.Int())
+	s.DefaultAttrs.Bg = Color(args.Int())
+	s.DefaultAttrs.Sp = Color(args.Int())
+
+	for id, def := range s.hlDefs {
+		s.hlAttrs[id] = s.cellAttrs(def)
+	}
+}
+
+// highlight returns the attributes of a highlight ID.  ID 0 and IDs that
+// weren't defined are the default attributes.
+func (s *Screen) highlight(id int) *CellAttrs {
+	if attrs, ok := s.hlAttrs[id]; ok {
+		return attrs
+	}
+	return s.DefaultAttrs
+}
+
+// gridLine draws a run of cells in a row.  A cell without a highlight ID uses
+// the previous cell's.  The cell after a double width character has an empty
+// string.
+func (s *Screen) gridLine(args *opArgs) {
+	row := args.Int()
+	col := args.Int()
+	cells := args.Array()
+
+	if row < 0 || row >= s.Size.Y {
+		return
+	}
+
+	// Drawing a line doesn't move the cursor.
+	cursor := s.Cursor
+	curAttrs := s.CurAttrs
+	defer func() {
+		s.Cursor = cursor
+		s.CurAttrs = curAttrs
+	}()
+
+	i := row*s.Size.X + col
+	end := (row + 1) * s.Size.X
+	hlID := 0
+
+	for cells.Len() > 0 {
+		cell := cells.Array()
+		text := cell.Text()
+		if cell.Len() > 0 {
+			hlID = cell.Int()
+		}
+		repeat := 1
+		if cell.Len() > 0 {
+			repeat = cell.Int()
+		}
+
+		s.CurAttrs = s.highlight(hlID)
+
+		// Cells only hold one rune, so combining characters after the first
+		// rune are dropped.
+		c := ' '
+		for _, r := range text {
+			c = r
+			break
+		}
+
+		for ; repeat > 0 && i < end; repeat-- {
+			if text != "" {
+				s.setChar(i, c)
+			}
+			i++
+		}
+	}
+}
+
+// gridScroll scrolls a region of the grid.  The region's bottom and right
+// bounds are exclusive.  nvim redraws the lines that are scrolled into view.
+func (s *Screen) gridScroll(args *opArgs) {
+	top := args.Int()
+	bot := args.Int()
+	left := args.Int()
+	right := args.Int()
+	rows := args.Int()
+
+	s.scroll.tl.Y = top
+	s.scroll.br.Y = bot - 1
+	s.scroll.tl.X = left
+	s.scroll.br.X = right - 1
+	s.scrollRegion(rows)
+}
@@ -0,0 +1,180 @@
+package screen
+
+import "testing"
+
+// gridText returns the characters of a screen's rows.
+func gridText(s *Screen) []string {
+	rows := make([]string, s.Size.Y)
+	for y := range rows {
+		line := make([]rune, s.Size.X)
+		for x := range line {
+			line[x] = s.Buffer[y*s.Size.X+x].Char
+		}
+		rows[y] = string(line)
+	}
+	return rows
+}
+
+// cells builds grid_line cells.  Each cell is its text, optionally followed by
+// its highlight ID and repeat count.
+func cells(c ...[]interface{}) []interface{} {
+	list := make([]interface{}, len(c))
+	for i := range c {
+		list[i] = c[i]
+	}
+	return list
+}
+
+func cell(args ...interface{}) []interface{} {
+	return args
+}
+
+func TestGridLine(t *testing.T) {
+	tests := []struct {
+		name  string
+		grid  int64
+		row   int64
+		col   int64
+		cells []interface{}
+		want  []string
+		hl    []int // Highlight IDs of the first row's cells.
+	}{
+		{
+			name:  "text",
+			grid:  globalGrid,
+			cells: cells(cell("a"), cell("b"), cell("c")),
+			want:  []string{"abc  ", "     "},
+		},
+		{
+			name:  "offset",
+			grid:  globalGrid,
+			row:   1,
+			col:   2,
+			cells: cells(cell("x"), cell("y")),
+			want:  []string{"     ", "  xy "},
+		},
+		{
+			name:  "repeat",
+			grid:  globalGrid,
+			cells: cells(cell("-", int64(0), int64(3)), cell("!")),
+			want:  []string{"---! ", "     "},
+		},
+		{
+			name:  "repeat past the end of the row",
+			grid:  globalGrid,
+			col:   3,
+			cells: cells(cell("=", int64(0), int64(10))),
+			want:  []string{"   ==", "     "},
+		},
+		{
+			name:  "highlight carries over",
+			grid:  globalGrid,
+			cells: cells(cell("a", int64(1)), cell("b"), cell("c", int64(0))),
+			want:  []string{"abc  ", "     "},
+			hl:    []int{1, 1, 0},
+		},
+		{
+			// The cell after a double width character keeps its old content.
+			name:  "double width",
+			grid:  globalGrid,
+			cells: cells(cell("漢"), cell(""), cell("a")),
+			want:  []string{"漢 a  ", "     "},
+		},
+		{
+			name:  "combining characters are dropped",
+			grid:  globalGrid,
+			cells: cells(cell("é"), cell("x")),
+			want:  []string{"ex   ", "     "},
+		},
+		{
+			name:  "other grids are ignored",
+			grid:  2,
+			cells: cells(cell("a")),
+			want:  []string{"     ", "     "},
+		},
+		{
+			name:  "row out of range",
+			grid:  globalGrid,
+			row:   5,
+			cells: cells(cell("a")),
+			want:  []string{"     ", "     "},
+		},
+	}
+
+	for _, test := range tests {
+		s := NewScreen(5, 2)
+		s.hlAttrs[1] = &CellAttrs{id: 100, Attrs: AttrBold}
+		s.setCursor(4, 1)
+
+		s.linegridOp("grid_line", &opArgs{args: []interface{}{
+			test.grid, test.row, test.col, test.cells,
+		}})
+
+		got := gridText(s)
+		for y := range test.want {
+			if got[y] != test.want[y] {
+				t.Errorf("%s: row %d = %q, want %q", test.name, y, got[y], test.want[y])
+			}
+		}
+
+		for x, id := range test.hl {
+			if attrs := s.Buffer[x].CellAttrs; attrs != s.highlight(id) {
+				t.Errorf("%s: cell %d has highlight %v, want %d", test.name, x, attrs, id)
+			}
+		}
+
+		if s.Cursor.X != 4 || s.Cursor.Y != 1 {
+			t.Errorf("%s: cursor moved to %v", test.name, s.Cursor)
+		}
+	}
+}
+
+func TestGridScroll(t *testing.T) {
+	tests := []struct {
+		name string
+
+		// Region bounds.  The bottom and right are exclusive.
+		top, bot, left, right int64
+		rows                  int64
+		want                  []string
+	}{
+		{
+			name: "up",
+			top:  0, bot: 4, left: 0, right: 3,
+			rows: 1,
+			want: []string{"bbb", "ccc", "ddd", "   "},
+		},
+		{
+			name: "down",
+			top:  0, bot: 4, left: 0, right: 3,
+			rows: -2,
+			want: []string{"   ", "   ", "aaa", "bbb"},
+		},
+		{
+			name: "region",
+			top:  1, bot: 3, left: 1, right: 3,
+			rows: 1,
+			want: []string{"aaa", "bcc", "c  ", "ddd"},
+		},
+	}
+
+	for _, test := range tests {
+		s := NewScreen(3, 4)
+		for y, c := range "abcd" {
+			for x := 0; x < 3; x++ {
+				s.Buffer[y*3+x].Char = c
+			}
+		}
+
+		s.linegridOp("grid_scroll", &opArgs{args: []interface{}{
+			int64(globalGrid), test.top, test.bot, test.left, test.right, test.rows, int64(0),
+		}})
+
+		got := gridText(s)
+		for y := range test.want {
+			if got[y] != test.want[y] {
+				t.Errorf("%s: row %d = %q, want %q", test.name, y, got[y], test.want[y])
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package screen
+
+// Message is a message shown by nvim when messages are drawn by the client.
+type Message struct {
+	// The kind of message, e.g. "emsg" or "echo".  It's empty for unknown
+	// kinds.
+	Kind    string
+	Content string
+}
+
+// Messages is the state of the messages drawn by the client.
+type Messages struct {
+	// Messages shown since the last msg_clear.
+	Shown []Message
+
+	// Text that's normally shown in the last line: the current mode, the
+	// pending command, and the ruler.
+	Showmode string
+	Showcmd  string
+	Ruler    string
+}
+
+func (s *Screen) msgShow(args *opArgs) {
+	m := Message{
+		Kind:    args.Text(),
+		Content: chunksText(args.Array()),
+	}
+	replace, _ := args.next().(bool)
+
+	if replace && len(s.messages.Shown) > 0 {
+		s.messages.Shown[len(s.messages.Shown)-1] = m
+	} else {
+		s.messages.Shown = append(s.messages.Shown, m)
+	}
+
+	s.writeMessage(m, replace)
+}
+
+func (s *Screen) msgHistoryShow(args *opArgs) {
+	entries := args.Array()
+	history := make([]Message, 0, entries.Len())
+	for entries.Len() > 0 {
+		entry := entries.Array()
+		history = append(history, Message{
+			Kind:    entry.Text(),
+			Content: chunksText(entry.Array()),
+		})
+	}
+
+	p := s.payload
+	p.WriteOp(OpMessageHistory)
+	p.WriteEncodedInt(len(history))
+	for _, m := range history {
+		p.WriteStringRun(m.Kind)
+		p.WriteStringRun(m.Content)
+	}
+}
+
+// MessagesState returns a copy of the messages' state.
+func (s *Screen) MessagesState() Messages {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := s.messages
+	m.Shown = append([]Message(nil), m.Shown...)
+	return m
+}
+
+func (s *Screen) writeMessage(m Message, replace bool) {
+	p := s.payload
+	p.WriteOp(OpMessageShow)
+	p.WriteStringRun(m.Kind)
+	p.WriteStringRun(m.Content)
+	if replace {
+		p.WriteByte(1)
+	} else {
+		p.WriteByte(0)
+	}
+}
+
+func (s *Screen) writeMessageStatus(op Op, text string) {
+	s.payload.WriteOp(op)
+	s.payload.WriteStringRun(text)
+}
+
+// writeMessagesState sends the shown messages and the last line's text to a
+// new client.
+func (s *Screen) writeMessagesState() {
+	for _, m := range s.messages.Shown {
+		s.writeMessage(m, false)
+	}
+
+	if s.messages.Showmode != "" {
+		s.writeMessageStatus(OpMessageShowmode, s.messages.Showmode)
+	}
+	if s.messages.Showcmd != "" {
+		s.writeMessageStatus(OpMessageShowcmd, s.messages.Showcmd)
+	}
+	if s.messages.Ruler != "" {
+		s.writeMessageStatus(OpMessageRuler, s.messages.Ruler)
+	}
+}
@@ -31,6 +31,17 @@ func anyInt(val interface{}) int64 {
 		return int64(v)
 	}
 
+	// Handles of buffers, windows, and tabpages are decoded into named integer
+	// types.
+	if val != nil {
+		switch v := reflect.ValueOf(val); v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return v.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return int64(v.Uint())
+		}
+	}
+
 	return 0
 }
 
@@ -78,6 +89,24 @@ func (r *opArgs) Uint32() uint32 {
 	return uint32(anyInt(r.next()))
 }
 
+// Text returns the next argument as a single string.  Unlike String, it doesn't
+// join consecutive strings.
+func (r *opArgs) Text() string {
+	str, _ := r.next().(string)
+	return str
+}
+
+// Array returns the next argument's items.
+func (r *opArgs) Array() *opArgs {
+	a, _ := r.next().([]interface{})
+	return &opArgs{args: a}
+}
+
+// Len returns the number of remaining arguments.
+func (r *opArgs) Len() int {
+	return len(r.args)
+}
+
 func (r *opArgs) Map() opMap {
 	a := r.next()
 	switch v := a.(type) {
@@ -129,3 +158,13 @@ func (o opMap) Int(key string) (int, bool) {
 	v, ok := o.Int64(key)
 	return int(v), ok
 }
+
+func (o opMap) String(key string) (string, bool) {
+	val, ok := o.args[key]
+	if !ok {
+		return "", false
+	}
+
+	str, ok := val.(string)
+	return str, ok
+}
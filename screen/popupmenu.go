@@ -0,0 +1,84 @@
+package screen
+
+// PopupmenuItem is a completion item.
+type PopupmenuItem struct {
+	Word string
+	Kind string
+	Menu string
+	Info string
+}
+
+// Popupmenu is the state of nvim's completion menu when it's drawn by the
+// client.
+type Popupmenu struct {
+	Visible bool
+	Items   []PopupmenuItem
+
+	// Index of the selected item, or -1 if none is selected.
+	Selected int
+
+	// Position of the menu's anchor.  The menu is drawn below it.
+	Row int
+	Col int
+}
+
+func (s *Screen) popupmenuShow(args *opArgs) {
+	items := args.Array()
+	pum := Popupmenu{
+		Visible: true,
+		Items:   make([]PopupmenuItem, 0, items.Len()),
+	}
+
+	for items.Len() > 0 {
+		item := items.Array()
+		pum.Items = append(pum.Items, PopupmenuItem{
+			Word: item.Text(),
+			Kind: item.Text(),
+			Menu: item.Text(),
+			Info: item.Text(),
+		})
+	}
+
+	pum.Selected = args.Int()
+	pum.Row = args.Int()
+	pum.Col = args.Int()
+
+	s.popupmenu = pum
+	s.writePopupmenu()
+}
+
+// PopupmenuState returns a copy of the completion menu's state.
+func (s *Screen) PopupmenuState() Popupmenu {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pum := s.popupmenu
+	pum.Items = append([]PopupmenuItem(nil), pum.Items...)
+	return pum
+}
+
+// writePopupmenu sends the completion menu.  The selected index is offset by
+// one so that no selection is zero.
+func (s *Screen) writePopupmenu() {
+	p := s.payload
+	pum := &s.popupmenu
+
+	if !pum.Visible {
+		p.WriteOp(OpPopupmenuHide)
+		return
+	}
+
+	p.WriteOp(OpPopupmenuShow)
+	p.WriteEncodedInts(pum.Row, pum.Col, pum.Selected+1, len(pum.Items))
+	for _, item := range pum.Items {
+		p.WriteStringRun(item.Word)
+		p.WriteStringRun(item.Kind)
+		p.WriteStringRun(item.Menu)
+		p.WriteStringRun(item.Info)
+	}
+}
+
+func (s *Screen) writePopupmenuSelect() {
+	s.payload.WriteOp(OpPopupmenuSelect)
+	s.payload.WriteEncodedInt(s.popupmenu.Selected + 1)
+}
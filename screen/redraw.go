@@ -42,55 +42,7 @@ func (s *Screen) redrawOp(op string, args *opArgs) {
 		s.DefaultAttrs.Sp = Color(args.Int())
 
 	case "highlight_set":
-		m := args.Map()
-		attrs := *s.DefaultAttrs
-
-		if c, ok := m.Int64("foreground"); ok {
-			attrs.Fg = Color(c)
-		}
-
-		if c, ok := m.Int64("background"); ok {
-			attrs.Bg = Color(c)
-		}
-
-		if c, ok := m.Int64("special"); ok {
-			attrs.Sp = Color(c)
-		}
-
-		if b, ok := m.Bool("reverse"); ok && b {
-			attrs.Attrs |= AttrReverse
-		}
-
-		if b, ok := m.Bool("italic"); ok && b {
-			attrs.Attrs |= AttrItalic
-		}
-
-		if b, ok := m.Bool("bold"); ok && b {
-			attrs.Attrs |= AttrBold
-		}
-
-		if b, ok := m.Bool("underline"); ok && b {
-			attrs.Attrs |= AttrUnderline
-		}
-
-		if b, ok := m.Bool("undercurl"); ok && b {
-			attrs.Attrs |= AttrUndercurl
-		}
-
-		// Try to reuse a pointer to an existing color that matches the one that was
-		// just set.
-		for existing := range s.attrCounter {
-			e := *existing
-			e.id = 0
-			if attrs == e {
-				s.CurAttrs = existing
-				return
-			}
-		}
-
-		s.attrID++
-		attrs.id = s.attrID
-		s.CurAttrs = &attrs
+		s.CurAttrs = s.cellAttrs(args.Map())
 
 	case "put":
 		i := s.Cursor.Y*s.Size.X + s.Cursor.X
@@ -106,45 +58,7 @@ func (s *Screen) redrawOp(op string, args *opArgs) {
 		s.scroll.br.X = args.Int()
 
 	case "scroll":
-		amount := args.Int()
-		sr := s.scroll
-		blank := make([]Cell, (sr.br.X-sr.tl.X)+1)
-		for i := range blank {
-			blank[i].Char = ' '
-			blank[i].Sent = false
-			blank[i].CellAttrs = s.DefaultAttrs
-		}
-
-		ys := amount
-		h := (sr.br.Y - sr.tl.Y) + 1
-		if amount < 0 {
-			// Down
-			ys = -amount
-		}
-
-		var sy, dy int
-
-		// Copying must go from top to bottom regardless of the scroll direction.
-		for y := ys; y < h; y++ {
-			if amount < 0 {
-				dy = sr.br.Y + ys - y
-				sy = dy + amount
-			} else {
-				sy = sr.tl.Y + y
-				dy = sy - amount
-			}
-
-			sy *= s.Size.X
-			dy *= s.Size.X
-
-			src := s.Buffer[sy+sr.tl.X : sy+sr.br.X+1]
-			dst := s.Buffer[dy+sr.tl.X : dy+sr.br.X+1]
-
-			copy(dst, src)
-			copy(src, blank) // Always blank the source line.
-		}
-
-		s.writeScroll(amount)
+		s.scrollRegion(args.Int())
 
 	case "set_title":
 		s.Title = args.String()
@@ -189,6 +103,7 @@ func (s *Screen) redrawOp(op string, args *opArgs) {
 	case "mode_change":
 		mode := args.String()
 		log.Println("Mode change:", mode)
+		s.ModeName = mode
 
 		switch mode {
 		case "normal":
@@ -200,14 +115,78 @@ func (s *Screen) redrawOp(op string, args *opArgs) {
 		}
 
 	case "popupmenu_show":
-		// TODO
+		s.popupmenuShow(args)
 
 	case "popupmenu_select":
+		s.popupmenu.Selected = args.Int()
+		s.writePopupmenuSelect()
 
 	case "popupmenu_hide":
+		s.popupmenu = Popupmenu{Selected: -1}
+		s.writePopupmenu()
+
+	case "tabline_update":
+		s.tablineUpdate(args)
+
+	case "cmdline_show":
+		s.cmdlineShow(args)
+
+	case "cmdline_pos":
+		s.cmdlinePos(args)
+
+	case "cmdline_special_char":
+		s.cmdlineSpecialChar(args)
+
+	case "cmdline_hide":
+		s.cmdlineHide(args)
+
+	case "cmdline_block_show":
+		s.cmdlineBlockShow(args)
+
+	case "cmdline_block_append":
+		s.cmdlineBlockAppend(args)
+
+	case "cmdline_block_hide":
+		s.cmdlineBlockHide()
+
+	case "wildmenu_show":
+		s.wildmenuShow(args)
+
+	case "wildmenu_select":
+		s.wildmenu.Selected = args.Int()
+		s.payload.WriteOp(OpWildmenuSelect)
+		s.payload.WriteEncodedInt(s.wildmenu.Selected + 1)
+
+	case "wildmenu_hide":
+		s.wildmenu = Wildmenu{Selected: -1}
+		s.writeWildmenu()
+
+	case "msg_show":
+		s.msgShow(args)
+
+	case "msg_clear":
+		s.messages.Shown = nil
+		s.payload.WriteOp(OpMessageClear)
+
+	case "msg_showmode":
+		s.messages.Showmode = chunksText(args.Array())
+		s.writeMessageStatus(OpMessageShowmode, s.messages.Showmode)
+
+	case "msg_showcmd":
+		s.messages.Showcmd = chunksText(args.Array())
+		s.writeMessageStatus(OpMessageShowcmd, s.messages.Showcmd)
+
+	case "msg_ruler":
+		s.messages.Ruler = chunksText(args.Array())
+		s.writeMessageStatus(OpMessageRuler, s.messages.Ruler)
+
+	case "msg_history_show":
+		s.msgHistoryShow(args)
 
 	default:
-		log.Printf("Unknown redraw op: %s, %#v", op, args.args)
+		if !s.linegridOp(op, args) {
+			log.Printf("Unknown redraw op: %s, %#v", op, args.args)
+		}
 	}
 }
 
@@ -225,10 +204,22 @@ func (s *Screen) redrawFinalize(curFlush int) {
 	}
 }
 
+// redrawActivity returns the activity caused by a redraw op.
+func redrawActivity(op string) Activity {
+	switch op {
+	case "bell", "visual_bell":
+		return ActivityBell
+	case "clear", "eol_clear", "put", "scroll", "grid_clear", "grid_line", "grid_scroll":
+		return ActivityOutput
+	}
+	return ActivityNone
+}
+
 // RedrawHandler deals with the msgpack input from Neovim
 func (s *Screen) RedrawHandler(updates ...[]interface{}) {
 	s.mu.Lock()
 	s.flushCount++
+	activity := ActivityNone
 
 oploop:
 	for _, args := range updates {
@@ -241,6 +232,10 @@ oploop:
 			break oploop
 		}
 
+		if a := redrawActivity(op); a > activity {
+			activity = a
+		}
+
 		for _, u := range args[1:] {
 			switch a := u.(type) {
 			case []interface{}:
@@ -257,6 +252,11 @@ oploop:
 	if err := s.flush(false); err != nil {
 		log.Println("Couldn't flush data:", err)
 	}
+	handler := s.activityHandler
 	s.mu.Unlock()
 	go s.redrawFinalize(s.flushCount)
+
+	if handler != nil && activity != ActivityNone {
+		handler(activity)
+	}
 }
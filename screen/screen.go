@@ -33,6 +33,31 @@ type ScrollRegion struct {
 	br Vector2
 }
 
+// Activity is what happened on the screen during a redraw.
+type Activity uint8
+
+// Activities in order of importance.
+const (
+	ActivityNone Activity = iota
+	ActivityOutput
+	ActivityBell
+)
+
+func (a Activity) String() string {
+	switch a {
+	case ActivityOutput:
+		return "output"
+	case ActivityBell:
+		return "bell"
+	}
+	return "none"
+}
+
+// MarshalText allows the activity to be used in JSON responses.
+func (a Activity) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
 type Screen struct {
 	mu              sync.Mutex
 	Size            Vector2 // Screen size.
@@ -43,6 +68,27 @@ type Screen struct {
 	// Current mode.
 	Mode Mode
 
+	// Name of the current mode reported by nvim.
+	ModeName string
+
+	// Completion menu drawn by the client.
+	popupmenu Popupmenu
+
+	// Tabpages drawn by the client.
+	tabline Tabline
+
+	// Command-lines drawn by the client by level, the lines of a block being
+	// entered, e.g. after :function, and the wildmenu.
+	cmdlines     []Cmdline
+	cmdlineBlock []string
+	wildmenu     Wildmenu
+
+	// Messages drawn by the client.
+	messages Messages
+
+	// Called after a redraw that changed the screen or rang the bell.
+	activityHandler func(Activity)
+
 	// Mouse state. This updates Mode.
 	Mouse bool
 
@@ -69,6 +115,11 @@ type Screen struct {
 
 	attrID uint32
 
+	// Highlights defined with hl_attr_define by ID, and the definitions so
+	// they can be updated when the default colors change.
+	hlAttrs map[int]*CellAttrs
+	hlDefs  map[int]opMap
+
 	flushCount int
 
 	// Region to scroll.
@@ -96,7 +147,11 @@ func NewScreen(w, h int) *Screen {
 		CurAttrs:        attrs,
 		attrCounter:     make(map[*CellAttrs]int),
 		sentAttrs:       make(map[*CellAttrs]int),
+		hlAttrs:         make(map[int]*CellAttrs),
+		hlDefs:          make(map[int]opMap),
 		Mode:            ModeNormal | ModeMouseOn,
+		popupmenu:       Popupmenu{Selected: -1},
+		wildmenu:        Wildmenu{Selected: -1},
 		payload:         &StreamBuffer{},
 		buf:             &StreamBuffer{},
 	}
@@ -116,9 +171,86 @@ func (s *Screen) SetSink(w io.Writer) {
 	s.writeSize()
 	s.writeClear()
 	s.flushScreen(true)
+	if len(s.tabline.Tabs) > 0 {
+		s.writeTabline()
+	}
+	s.writeCmdlineState()
+	s.writeMessagesState()
+	if s.popupmenu.Visible {
+		s.writePopupmenu()
+	}
 	s.flush(true)
 }
 
+// ResetExternal forgets the state of the UI elements drawn by clients and tells
+// the client to hide them.  It's used before the UI is reattached since nvim
+// sends the state of the elements that are still externalized.
+func (s *Screen) ResetExternal() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.popupmenu.Visible {
+		s.popupmenu = Popupmenu{Selected: -1}
+		s.writePopupmenu()
+	}
+
+	if len(s.tabline.Tabs) > 0 {
+		s.tabline = Tabline{}
+		s.writeTabline()
+	}
+
+	if len(s.cmdlineBlock) > 0 {
+		s.cmdlineBlockHide()
+	}
+
+	if len(s.cmdlines) > 0 {
+		s.cmdlines = nil
+		s.payload.WriteOp(OpCmdlineHide)
+		s.payload.WriteEncodedInt(1)
+	}
+
+	if s.wildmenu.Visible {
+		s.wildmenu = Wildmenu{Selected: -1}
+		s.writeWildmenu()
+	}
+
+	if len(s.messages.Shown) > 0 {
+		s.payload.WriteOp(OpMessageClear)
+	}
+	if s.messages.Showmode != "" {
+		s.writeMessageStatus(OpMessageShowmode, "")
+	}
+	if s.messages.Showcmd != "" {
+		s.writeMessageStatus(OpMessageShowcmd, "")
+	}
+	if s.messages.Ruler != "" {
+		s.writeMessageStatus(OpMessageRuler, "")
+	}
+	s.messages = Messages{}
+}
+
+// SetActivityHandler sets the function called after redraws that change the
+// screen or ring the bell.  It's called outside of the screen's lock.
+func (s *Screen) SetActivityHandler(fn func(Activity)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activityHandler = fn
+}
+
+// Status returns the title and the name of the current mode.
+func (s *Screen) Status() (string, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Title, s.ModeName
+}
+
+// Sink returns the writer receiving operation writes.
+func (s *Screen) Sink() io.Writer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink
+}
+
 func (s *Screen) flushScreen(all bool) {
 	if all {
 		s.writeRange(0, len(s.Buffer))
@@ -159,6 +291,102 @@ func (s *Screen) setCellAttrs(index int, attrs *CellAttrs) {
 	}
 }
 
+// cellAttrs returns the attributes for a highlight definition.  Colors that
+// aren't defined are the default colors.  A pointer to existing attributes that
+// match is reused.
+func (s *Screen) cellAttrs(m opMap) *CellAttrs {
+	attrs := *s.DefaultAttrs
+
+	if c, ok := m.Int64("foreground"); ok {
+		attrs.Fg = Color(c)
+	}
+
+	if c, ok := m.Int64("background"); ok {
+		attrs.Bg = Color(c)
+	}
+
+	if c, ok := m.Int64("special"); ok {
+		attrs.Sp = Color(c)
+	}
+
+	if b, ok := m.Bool("reverse"); ok && b {
+		attrs.Attrs |= AttrReverse
+	}
+
+	if b, ok := m.Bool("italic"); ok && b {
+		attrs.Attrs |= AttrItalic
+	}
+
+	if b, ok := m.Bool("bold"); ok && b {
+		attrs.Attrs |= AttrBold
+	}
+
+	if b, ok := m.Bool("underline"); ok && b {
+		attrs.Attrs |= AttrUnderline
+	}
+
+	if b, ok := m.Bool("undercurl"); ok && b {
+		attrs.Attrs |= AttrUndercurl
+	}
+
+	// Try to reuse a pointer to an existing color that matches the one that was
+	// just set.
+	for existing := range s.attrCounter {
+		e := *existing
+		e.id = 0
+		if attrs == e {
+			return existing
+		}
+	}
+
+	s.attrID++
+	attrs.id = s.attrID
+	return &attrs
+}
+
+// scrollRegion scrolls the scroll region by amount lines.  The region scrolls
+// up if amount is positive.
+func (s *Screen) scrollRegion(amount int) {
+	sr := s.scroll
+	blank := make([]Cell, (sr.br.X-sr.tl.X)+1)
+	for i := range blank {
+		blank[i].Char = ' '
+		blank[i].Sent = false
+		blank[i].CellAttrs = s.DefaultAttrs
+	}
+
+	ys := amount
+	h := (sr.br.Y - sr.tl.Y) + 1
+	if amount < 0 {
+		// Down
+		ys = -amount
+	}
+
+	var sy, dy int
+
+	// Copying must go from top to bottom regardless of the scroll direction.
+	for y := ys; y < h; y++ {
+		if amount < 0 {
+			dy = sr.br.Y + ys - y
+			sy = dy + amount
+		} else {
+			sy = sr.tl.Y + y
+			dy = sy - amount
+		}
+
+		sy *= s.Size.X
+		dy *= s.Size.X
+
+		src := s.Buffer[sy+sr.tl.X : sy+sr.br.X+1]
+		dst := s.Buffer[dy+sr.tl.X : dy+sr.br.X+1]
+
+		copy(dst, src)
+		copy(src, blank) // Always blank the source line.
+	}
+
+	s.writeScroll(amount)
+}
+
 // clearLine is a helper for clearing a line.
 func (s *Screen) clearLine(x, y int) {
 	i1 := y*s.Size.X + x
@@ -0,0 +1,50 @@
+package screen
+
+// Tab is one of nvim's tabpages.
+type Tab struct {
+	Handle int
+	Name   string
+}
+
+// Tabline is the state of nvim's tabline when it's drawn by the client.
+type Tabline struct {
+	// Handle of the current tab.
+	Current int
+	Tabs    []Tab
+}
+
+func (s *Screen) tablineUpdate(args *opArgs) {
+	tl := Tabline{Current: args.Int()}
+
+	tabs := args.Array()
+	tl.Tabs = make([]Tab, 0, tabs.Len())
+	for tabs.Len() > 0 {
+		tab := tabs.Map()
+		handle, _ := tab.Int("tab")
+		name, _ := tab.String("name")
+		tl.Tabs = append(tl.Tabs, Tab{Handle: handle, Name: name})
+	}
+
+	s.tabline = tl
+	s.writeTabline()
+}
+
+// TablineState returns a copy of the tabline's state.
+func (s *Screen) TablineState() Tabline {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tl := s.tabline
+	tl.Tabs = append([]Tab(nil), tl.Tabs...)
+	return tl
+}
+
+func (s *Screen) writeTabline() {
+	p := s.payload
+	p.WriteOp(OpTabline)
+	p.WriteEncodedInts(s.tabline.Current, len(s.tabline.Tabs))
+	for _, tab := range s.tabline.Tabs {
+		p.WriteEncodedInt(tab.Handle)
+		p.WriteStringRun(tab.Name)
+	}
+}
@@ -0,0 +1,345 @@
+package nmux
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tweekmonster/nmux/util"
+)
+
+const (
+	stateFile      = "state.json"
+	workspacesFile = "workspaces.json"
+)
+
+// sessionState is the saved state of a single process.
+type sessionState struct {
+	ID      int            `json:"id"`
+	Name    string         `json:"name"`
+	Options ProcessOptions `json:"options"`
+}
+
+// SessionStore saves the sessions of managed processes to a state directory
+// so they can be restored after the server restarts.  Each process gets a
+// directory named after its ID containing session.vim and main.shada.
+type SessionStore struct {
+	Dir string
+
+	// Stop processes that have been without a client for this long after
+	// saving their sessions.  They're restored the next time the server
+	// starts.  Zero disables reaping.
+	ReapAfter time.Duration
+
+	manager *ProcessManager
+
+	mu     sync.Mutex
+	stop   chan struct{}
+	done   chan struct{}
+	reaped map[int]sessionState
+}
+
+// NewSessionStore creates a session store for a process manager.
+func NewSessionStore(dir string, manager *ProcessManager) *SessionStore {
+	s := &SessionStore{
+		Dir:     dir,
+		manager: manager,
+		reaped:  make(map[int]sessionState),
+	}
+
+	manager.mu.Lock()
+	manager.sessions = s
+	manager.mu.Unlock()
+
+	return s
+}
+
+func (s *SessionStore) sessionFiles(id int) (string, string) {
+	dir := filepath.Join(s.Dir, strconv.Itoa(id))
+	return filepath.Join(dir, "session.vim"), filepath.Join(dir, "main.shada")
+}
+
+// sessionArgs returns the nvim arguments that load a saved session.
+func (s *SessionStore) sessionArgs(id int) []string {
+	session, shada := s.sessionFiles(id)
+	args := []string{"-i", shada}
+	if _, err := os.Stat(session); err == nil {
+		args = append(args, "-S", session)
+	}
+	return args
+}
+
+// Save writes the sessions of all running processes and the state file.
+// Processes that fail to save, or that are starting or restarting, keep their
+// previous session files.
+func (s *SessionStore) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.Dir, 0700); err != nil {
+		return err
+	}
+
+	var states []sessionState
+	keep := map[string]bool{}
+	names := map[string]bool{}
+
+	for _, p := range s.manager.List() {
+		if p.State() == StateExited {
+			continue
+		}
+		name, opts := p.name(), p.options()
+		names[name] = true
+
+		session, shada := s.sessionFiles(p.ID)
+		if err := os.MkdirAll(filepath.Dir(session), 0700); err != nil {
+			return err
+		}
+
+		// A process that's starting may be loading its session files, so they
+		// aren't written until it's running.
+		if p.IsRunning() {
+			if err := p.SaveSession(session, shada); err != nil {
+				util.Print("Couldn't save session for", name, err)
+			}
+		}
+
+		keep[strconv.Itoa(p.ID)] = true
+		states = append(states, sessionState{
+			ID:      p.ID,
+			Name:    name,
+			Options: opts,
+		})
+	}
+
+	// Reaped processes are kept until their names are reused.
+	for id, st := range s.reaped {
+		if names[st.Name] {
+			delete(s.reaped, id)
+			continue
+		}
+		keep[strconv.Itoa(id)] = true
+		states = append(states, st)
+	}
+
+	if err := s.writeJSON(stateFile, states); err != nil {
+		return err
+	}
+
+	if err := s.writeJSON(workspacesFile, s.manager.Workspaces()); err != nil {
+		return err
+	}
+
+	// Remove sessions for processes that no longer exist.
+	entries, err := ioutil.ReadDir(s.Dir)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if _, err := strconv.Atoi(e.Name()); err == nil && e.IsDir() && !keep[e.Name()] {
+			os.RemoveAll(filepath.Join(s.Dir, e.Name()))
+		}
+	}
+
+	return nil
+}
+
+// writeJSON replaces a file in the state directory.
+func (s *SessionStore) writeJSON(name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(s.Dir, name+".tmp")
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filepath.Join(s.Dir, name))
+}
+
+// restoreWorkspaces loads the workspaces file.
+func (s *SessionStore) restoreWorkspaces() error {
+	data, err := ioutil.ReadFile(filepath.Join(s.Dir, workspacesFile))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var workspaces []*Workspace
+	if err := json.Unmarshal(data, &workspaces); err != nil {
+		return err
+	}
+
+	s.manager.setWorkspaces(workspaces)
+	return nil
+}
+
+// Restore loads the workspaces and starts the processes found in the state
+// file with their previous IDs and names.  Sessions are loaded with -S and
+// shada files with -i.
+func (s *SessionStore) Restore() error {
+	if err := s.restoreWorkspaces(); err != nil {
+		return err
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(s.Dir, stateFile))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var states []sessionState
+	if err := json.Unmarshal(data, &states); err != nil {
+		return err
+	}
+
+	for _, st := range states {
+		// The session opens the files that were open.
+		st.Options.Files = nil
+
+		util.Print("Restoring process:", st.Name)
+		if _, err := s.manager.create(st.ID, st.Name, st.Options, s.sessionArgs(st.ID)); err != nil {
+			util.Print("Couldn't restore process", st.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Reap saves the sessions of processes that have been without a client for
+// ReapAfter and stops them.  Processes with modified buffers or that weren't
+// started by this server are left running.
+func (s *SessionStore) Reap() {
+	if s.ReapAfter <= 0 {
+		return
+	}
+
+	for _, p := range s.manager.List() {
+		since := p.IdleSince()
+		if !p.IsRunning() || p.IsRemote() || since.IsZero() || time.Since(since) < s.ReapAfter {
+			continue
+		}
+
+		if modified, err := p.ModifiedBuffers(); err != nil || len(modified) > 0 {
+			continue
+		}
+
+		name, opts := p.name(), p.options()
+		session, shada := s.sessionFiles(p.ID)
+		if err := os.MkdirAll(filepath.Dir(session), 0700); err != nil {
+			util.Print("Couldn't reap", name, err)
+			continue
+		}
+
+		if err := p.SaveSession(session, shada); err != nil {
+			util.Print("Couldn't save session for", name, err)
+			continue
+		}
+
+		s.mu.Lock()
+		s.reaped[p.ID] = sessionState{
+			ID:      p.ID,
+			Name:    name,
+			Options: opts,
+		}
+		s.mu.Unlock()
+
+		util.Print("Reaping idle process:", name)
+		if err := p.Kill(); err != nil {
+			util.Print("Couldn't kill", name, err)
+		}
+	}
+}
+
+// restoreReaped starts a reaped process again with its saved session.  The
+// process keeps its ID and options, and files are opened in addition to the
+// session's.  It returns nil if no process with the name was reaped.
+func (s *SessionStore) restoreReaped(name string, files []string) (*Process, error) {
+	s.mu.Lock()
+	var st sessionState
+	found := false
+	for id, r := range s.reaped {
+		if r.Name == name {
+			st = r
+			found = true
+			delete(s.reaped, id)
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if !found {
+		return nil, nil
+	}
+
+	st.Options.Files = files
+
+	util.Print("Restoring reaped process:", st.Name)
+	p, err := s.manager.create(st.ID, st.Name, st.Options, s.sessionArgs(st.ID))
+	if err != nil {
+		// Keep the session so it isn't removed by the next save.
+		s.mu.Lock()
+		s.reaped[st.ID] = st
+		s.mu.Unlock()
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Start saves sessions and reaps idle processes at an interval until Stop is
+// called.
+func (s *SessionStore) Start(interval time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop != nil {
+		return
+	}
+
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+
+	go func(stop, done chan struct{}) {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				s.Reap()
+				if err := s.Save(); err != nil {
+					util.Print("Couldn't save sessions:", err)
+				}
+			}
+		}
+	}(s.stop, s.done)
+}
+
+// Stop stops saving at an interval and saves one last time.
+func (s *SessionStore) Stop() error {
+	s.mu.Lock()
+	stop, done := s.stop, s.done
+	s.stop = nil
+	s.done = nil
+	s.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+
+	return s.Save()
+}
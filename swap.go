@@ -0,0 +1,126 @@
+package nmux
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/neovim/go-client/nvim"
+	"github.com/tweekmonster/nmux/util"
+)
+
+// swapTimeout is how long a client has to answer a swap file dialog.
+const swapTimeout = time.Minute
+
+// SwapPolicy determines what happens when a file that's opened has a swap
+// file.  nvim's prompt blocks requests, so a policy prevents a stale swap
+// file from wedging a process.
+type SwapPolicy string
+
+// Swap policies.
+const (
+	// Let nvim prompt.
+	SwapPrompt SwapPolicy = ""
+
+	// Open the file read-only.
+	SwapReadOnly SwapPolicy = "readonly"
+
+	// Edit the file anyway.
+	SwapEdit SwapPolicy = "edit"
+
+	// Recover the file from the swap file.
+	SwapRecover SwapPolicy = "recover"
+
+	// Ask the attached client with a dialog.  The file is opened read-only if
+	// there's no client or it doesn't answer.
+	SwapAsk SwapPolicy = "ask"
+)
+
+// swapChoices are the values of v:swapchoice.
+var swapChoices = []DialogChoice{
+	{Key: "o", Label: "Open Read-Only"},
+	{Key: "e", Label: "Edit anyway"},
+	{Key: "r", Label: "Recover"},
+	{Key: "d", Label: "Delete it"},
+	{Key: "q", Label: "Quit"},
+	{Key: "a", Label: "Abort"},
+}
+
+// ParseSwapPolicy returns the SwapPolicy for a name.  "prompt" and an empty
+// string are SwapPrompt.
+func ParseSwapPolicy(name string) (SwapPolicy, error) {
+	switch name {
+	case "", "prompt":
+		return SwapPrompt, nil
+	case string(SwapReadOnly), string(SwapEdit), string(SwapRecover), string(SwapAsk):
+		return SwapPolicy(name), nil
+	}
+	return SwapPrompt, fmt.Errorf("unknown swap policy: %q", name)
+}
+
+// swapArgs returns the nvim arguments that define the SwapExists autocmd.
+// It's defined with --cmd so that files opened at startup are handled.
+func (p *Process) swapArgs() []string {
+	var choice string
+	switch p.options().Swap {
+	case SwapReadOnly:
+		choice = "'o'"
+	case SwapEdit:
+		choice = "'e'"
+	case SwapRecover:
+		choice = "'r'"
+	case SwapAsk:
+		// The channel isn't known until the UI is attached.
+		choice = `exists('g:nmux_channel') ? rpcrequest(g:nmux_channel, 'nmux_swap', expand('<afile>:p'), v:swapname) : 'o'`
+	default:
+		return nil
+	}
+
+	return []string{"--cmd", "autocmd SwapExists * let v:swapchoice = " + choice}
+}
+
+// setChannel lets nvim make requests to the process.  It must be called in a
+// request.
+func (p *Process) setChannel(n *nvim.Nvim) error {
+	if p.options().Swap != SwapAsk {
+		return nil
+	}
+
+	var info []interface{}
+	if err := n.Request("nvim_get_api_info", &info); err != nil {
+		return err
+	}
+
+	if len(info) == 0 {
+		return errors.New("unexpected api info")
+	}
+
+	id, err := strconv.ParseInt(fmt.Sprint(info[0]), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	return n.SetVar("nmux_channel", id)
+}
+
+// swapHandler asks the attached client what to do about a swap file.
+func (p *Process) swapHandler(file, swapname string) (string, error) {
+	message := fmt.Sprintf("Found a swap file by the name %q while opening %q.",
+		filepath.Base(swapname), file)
+
+	choice, err := p.Dialog("Swap file exists", message, swapChoices, swapTimeout)
+	if err != nil {
+		util.Print("Opening", file, "read-only in", p.name(), err)
+		return "o", nil
+	}
+
+	for _, c := range swapChoices {
+		if c.Key == choice {
+			return choice, nil
+		}
+	}
+
+	return "o", nil
+}
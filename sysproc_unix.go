@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package nmux
+
+import "syscall"
+
+// detachedProcAttr starts a process in a new session so it isn't affected by
+// signals sent to the server's process group.
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
@@ -0,0 +1,9 @@
+package nmux
+
+import "syscall"
+
+// detachedProcAttr starts a process in a new process group so it isn't
+// affected by console signals sent to the server.
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
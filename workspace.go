@@ -0,0 +1,307 @@
+package nmux
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tweekmonster/nmux/util"
+)
+
+var ErrWorkspaceExists = errors.New("workspace already exists")
+var ErrNoWorkspace = errors.New("no such workspace")
+
+// Workspace groups the processes used for a project.
+type Workspace struct {
+	Name string `json:"name"`
+
+	// Root directory of the project.  It's the default directory of the
+	// workspace's processes.
+	Root string `json:"root"`
+
+	// Arbitrary information for clients.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	Created  time.Time `json:"created"`
+	LastUsed time.Time `json:"last_used"`
+
+	// Options of the workspace's processes by process name.  They're started
+	// when the workspace is opened.
+	Processes map[string]ProcessOptions `json:"processes"`
+}
+
+// copy returns a copy of the workspace that can be used without holding the
+// manager's lock.
+func (w *Workspace) copy() *Workspace {
+	c := *w
+	c.Metadata = make(map[string]string, len(w.Metadata))
+	for k, v := range w.Metadata {
+		c.Metadata[k] = v
+	}
+	c.Processes = make(map[string]ProcessOptions, len(w.Processes))
+	for k, v := range w.Processes {
+		c.Processes[k] = v
+	}
+	return &c
+}
+
+// Names returns the names of the workspace's processes in order.
+func (w *Workspace) Names() []string {
+	names := make([]string, 0, len(w.Processes))
+	for name := range w.Processes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Contains returns true if path is inside the workspace's root.
+func (w *Workspace) Contains(path string) bool {
+	rel, err := filepath.Rel(w.Root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// CreateWorkspace creates an empty workspace.
+func (m *ProcessManager) CreateWorkspace(name, root string, metadata map[string]string) (*Workspace, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.workspaces[name]; ok {
+		return nil, ErrWorkspaceExists
+	}
+
+	now := time.Now()
+	ws := &Workspace{
+		Name:      name,
+		Root:      root,
+		Metadata:  metadata,
+		Created:   now,
+		LastUsed:  now,
+		Processes: make(map[string]ProcessOptions),
+	}
+	m.workspaces[name] = ws
+	return ws.copy(), nil
+}
+
+// RemoveWorkspace removes a workspace.  Its processes keep running.
+func (m *ProcessManager) RemoveWorkspace(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.workspaces[name]; !ok {
+		return ErrNoWorkspace
+	}
+	delete(m.workspaces, name)
+	return nil
+}
+
+// Workspace returns the workspace with the specified name, or nil.
+func (m *ProcessManager) Workspace(name string) *Workspace {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ws, ok := m.workspaces[name]; ok {
+		return ws.copy()
+	}
+	return nil
+}
+
+// Workspaces returns the workspaces ordered by name.
+func (m *ProcessManager) Workspaces() []*Workspace {
+	m.mu.Lock()
+	list := make([]*Workspace, 0, len(m.workspaces))
+	for _, ws := range m.workspaces {
+		list = append(list, ws.copy())
+	}
+	m.mu.Unlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// setWorkspaces replaces the workspaces with restored ones.
+func (m *ProcessManager) setWorkspaces(list []*Workspace) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.workspaces = make(map[string]*Workspace, len(list))
+	for _, ws := range list {
+		if ws.Processes == nil {
+			ws.Processes = make(map[string]ProcessOptions)
+		}
+		m.workspaces[ws.Name] = ws
+	}
+}
+
+// touchWorkspace updates the time a workspace was last used.
+func (m *ProcessManager) touchWorkspace(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ws, ok := m.workspaces[name]; ok {
+		ws.LastUsed = time.Now()
+	}
+}
+
+// workspaceOptions applies a workspace's defaults to the options of a process
+// joining it.
+func (m *ProcessManager) workspaceOptions(opts ProcessOptions) (ProcessOptions, error) {
+	if opts.Workspace == "" {
+		return opts, nil
+	}
+
+	ws := m.Workspace(opts.Workspace)
+	if ws == nil {
+		return opts, ErrNoWorkspace
+	}
+
+	if opts.Dir == "" {
+		opts.Dir = ws.Root
+	}
+	return opts, nil
+}
+
+// joinWorkspace adds a process to its workspace so it's started the next time
+// the workspace is opened.
+func (m *ProcessManager) joinWorkspace(p *Process) {
+	name, opts := p.name(), p.options()
+	if opts.Workspace == "" {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ws, ok := m.workspaces[opts.Workspace]; ok {
+		// Files are only opened when the process is first created.
+		opts.Files = nil
+		ws.Processes[name] = opts
+		ws.LastUsed = time.Now()
+	}
+}
+
+// workspaceProcesses returns the processes that joined a workspace by name.
+func (m *ProcessManager) workspaceProcesses(name string) map[string]*Process {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	procs := make(map[string]*Process)
+	for _, p := range m.procs {
+		if p.options().Workspace == name {
+			procs[p.name()] = p
+		}
+	}
+	return procs
+}
+
+// OpenWorkspace starts the workspace's processes that aren't running and
+// returns all of them.  A workspace without processes gets one named after
+// the workspace.  A process whose name is taken by a process outside the
+// workspace isn't started.
+func (m *ProcessManager) OpenWorkspace(name string) ([]*Process, error) {
+	ws := m.Workspace(name)
+	if ws == nil {
+		return nil, ErrNoWorkspace
+	}
+
+	if len(ws.Processes) == 0 {
+		opts := DefaultProcessOptions()
+		opts.Workspace = name
+		ws.Processes[name] = opts
+	}
+
+	var procs []*Process
+	for _, pname := range ws.Names() {
+		if p := m.workspaceProcesses(name)[pname]; p != nil {
+			procs = append(procs, p)
+			continue
+		}
+
+		p, err := m.Create(pname, ws.Processes[pname])
+		if err == ErrProcessExists {
+			// Another client may have started it first.
+			if p = m.workspaceProcesses(name)[pname]; p == nil {
+				util.Print("Couldn't open", pname, "in workspace", name, err)
+			}
+		} else if err != nil {
+			return procs, err
+		}
+
+		if p != nil {
+			procs = append(procs, p)
+		}
+	}
+
+	m.touchWorkspace(name)
+	return procs, nil
+}
+
+// WorkspaceForPath returns the workspace containing path.  If there isn't one
+// and path is inside a git repository, a workspace is created for the
+// repository's root.
+func (m *ProcessManager) WorkspaceForPath(path string) (*Workspace, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The workspace with the deepest root wins.
+	var found *Workspace
+	for _, ws := range m.Workspaces() {
+		if ws.Contains(path) && (found == nil || len(ws.Root) > len(found.Root)) {
+			found = ws
+		}
+	}
+
+	if found != nil {
+		return found, nil
+	}
+
+	root := gitRoot(path)
+	if root == "" {
+		return nil, ErrNoWorkspace
+	}
+
+	base := filepath.Base(root)
+	name := base
+	for i := 2; ; i++ {
+		ws, err := m.CreateWorkspace(name, root, nil)
+		if err != ErrWorkspaceExists {
+			return ws, err
+		}
+		name = base + "-" + strconv.Itoa(i)
+	}
+}
+
+// gitRoot returns the root of the git repository containing path, or an empty
+// string.  .git can be a file in worktrees and submodules.
+func gitRoot(path string) string {
+	dir := path
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}